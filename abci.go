@@ -0,0 +1,98 @@
+package token
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irismod/token/keeper"
+	"github.com/irismod/token/types"
+)
+
+// EndBlocker removes the pending owner transfers which have expired, releases the vesting schedules which end,
+// settles the symbol auctions whose reveal period ends, removes the expired symbol reservations
+// and marks the tokens whose symbol leases expire as lapsed
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	for _, transfer := range k.GetExpiredPendingTransfers(ctx, ctx.BlockHeight()) {
+		k.RemovePendingTransfer(ctx, transfer)
+
+		if err := types.EmitTypedEvents(ctx, &types.EventExpireTransferTokenOwner{
+			Symbol:       transfer.Symbol,
+			SrcOwner:     transfer.SrcOwner.String(),
+			DstOwner:     transfer.DstOwner.String(),
+			ExpireHeight: transfer.ExpireHeight,
+		}); err != nil {
+			k.Logger(ctx).Error("failed to emit the typed event", "symbol", transfer.Symbol, "err", err.Error())
+		}
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeExpireTransferTokenOwner,
+				sdk.NewAttribute(types.AttributeKeySymbol, transfer.Symbol),
+				sdk.NewAttribute(types.AttributeKeySrcOwner, transfer.SrcOwner.String()),
+				sdk.NewAttribute(types.AttributeKeyDstOwner, transfer.DstOwner.String()),
+			),
+		)
+	}
+
+	// only the ended schedules are released, the partial releases are claimed by the recipients. A schedule left unreleased,
+	// because the token is paused or the recipient is frozen, stays queued and is retried in the following blocks
+	for _, schedule := range k.GetEndedVestingSchedules(ctx, ctx.BlockTime()) {
+		// the release is isolated so that a failure leaves no partial transfers
+		cacheCtx, write := ctx.CacheContext()
+		released, err := k.ReleaseVestedCoins(cacheCtx, schedule)
+		if err != nil {
+			k.Logger(ctx).Error("failed to release the vested coins", "symbol", schedule.Symbol, "recipient", schedule.Recipient.String(), "err", err.Error())
+			continue
+		}
+		if !released.IsPositive() {
+			continue
+		}
+		write()
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeReleaseVesting,
+				sdk.NewAttribute(types.AttributeKeySymbol, schedule.Symbol),
+				sdk.NewAttribute(types.AttributeKeyRecipient, schedule.Recipient.String()),
+				sdk.NewAttribute(types.AttributeKeyAmount, released.String()),
+			),
+		)
+	}
+
+	for _, auction := range k.GetEndedAuctions(ctx, ctx.BlockHeight()) {
+		// the settlement is isolated so that a failure leaves no partial refunds
+		cacheCtx, write := ctx.CacheContext()
+		winner, err := k.SettleAuction(cacheCtx, auction)
+		if err != nil {
+			k.Logger(ctx).Error("failed to settle the auction", "symbol", auction.Symbol, "err", err.Error())
+			continue
+		}
+		write()
+
+		attributes := []sdk.Attribute{sdk.NewAttribute(types.AttributeKeySymbol, auction.Symbol)}
+		if winner != nil {
+			attributes = append(attributes,
+				sdk.NewAttribute(types.AttributeKeyWinner, winner.Bidder.String()),
+				sdk.NewAttribute(types.AttributeKeyAmount, winner.Amount.String()),
+			)
+		}
+		ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeSettleAuction, attributes...))
+	}
+
+	for _, reservation := range k.GetExpiredSymbolReservations(ctx, ctx.BlockHeight()) {
+		k.RemoveSymbolReservation(ctx, reservation)
+	}
+
+	for _, token := range k.GetExpiringTokens(ctx, ctx.BlockHeight()) {
+		if err := k.LapseToken(ctx, token); err != nil {
+			k.Logger(ctx).Error("failed to lapse the token", "symbol", token.Symbol, "err", err.Error())
+			continue
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeLapseToken,
+				sdk.NewAttribute(types.AttributeKeySymbol, token.Symbol),
+				sdk.NewAttribute(types.AttributeKeyOwner, token.Owner.String()),
+			),
+		)
+	}
+}
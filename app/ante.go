@@ -30,6 +30,7 @@ func NewAnteHandler(
 		ante.NewSigGasConsumeDecorator(ak, sigGasConsumer),
 		ante.NewSigVerificationDecorator(ak, signModeHandler),
 		ante.NewIncrementSequenceDecorator(ak),
-		tokenkeeper.NewValidateTokenFeeDecorator(tk, ak, bankKeeper),
+		tokenkeeper.NewValidateTokenFeeDecorator(tk),
+		tokenkeeper.NewValidateTokenTransferDecorator(tk),
 	)
 }
@@ -0,0 +1,143 @@
+package simapp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	tokentypes "github.com/irismod/token/types"
+)
+
+func TestTokenBalanceChanges(t *testing.T) {
+	ownerPriv, holderPriv := secp256k1.GenPrivKey(), secp256k1.GenPrivKey()
+	owner := sdk.AccAddress(ownerPriv.PubKey().Address())
+	holder := sdk.AccAddress(holderPriv.PubKey().Address())
+
+	genAccs := []authtypes.GenesisAccount{&authtypes.BaseAccount{Address: owner}, &authtypes.BaseAccount{Address: holder}}
+	app := SetupWithGenesisAccounts(genAccs, banktypes.Balance{
+		Address: owner,
+		Coins:   sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000000000000))),
+	})
+	txGen := MakeEncodingConfig().TxConfig
+
+	// the msgs are delivered through the ante handler and the bank keeper of the app
+	deliver := func(priv crypto.PrivKey, msgs ...sdk.Msg) {
+		ctx := app.BaseApp.NewContext(true, tmproto.Header{})
+		acc := app.AccountKeeper.GetAccount(ctx, sdk.AccAddress(priv.PubKey().Address()))
+
+		header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+		_, _, err := SignCheckDeliver(t, txGen, app.BaseApp, header, msgs, "", []uint64{acc.GetAccountNumber()}, []uint64{acc.GetSequence()}, true, true, priv)
+		require.NoError(t, err)
+	}
+	coins := func(denom string, amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(amount)))
+	}
+
+	deliver(ownerPriv, tokentypes.NewMsgIssueToken("btc", "satoshi", "Bitcoin Network", 0, sdk.NewInt(1000), sdk.NewInt(2000), true, owner))
+
+	// the transfer following the snapshot in the same tx is recorded
+	deliver(ownerPriv,
+		tokentypes.NewMsgSnapshotToken("btc", owner),
+		banktypes.NewMsgSend(owner, holder, coins("satoshi", 500)),
+	)
+
+	deliver(ownerPriv, tokentypes.NewMsgDistributeDividend("btc", owner, coins(sdk.DefaultBondDenom, 1000)))
+
+	// the dividends accrued by the holder are settled before the holder transfers the tokens away
+	deliver(holderPriv, banktypes.NewMsgSend(holder, owner, coins("satoshi", 200)))
+
+	ctx := app.BaseApp.NewContext(true, tmproto.Header{})
+
+	balance, err := app.TokenKeeper.GetBalanceAt(ctx, "btc", 1, owner)
+	require.NoError(t, err)
+	require.Equal(t, "1000satoshi", balance.String())
+
+	balance, err = app.TokenKeeper.GetBalanceAt(ctx, "btc", 1, holder)
+	require.NoError(t, err)
+	require.Equal(t, "0satoshi", balance.String())
+
+	dividends, err := app.TokenKeeper.GetDividends(ctx, "btc", holder)
+	require.NoError(t, err)
+	require.Equal(t, coins(sdk.DefaultBondDenom, 500), dividends)
+
+	dividends, err = app.TokenKeeper.GetDividends(ctx, "btc", owner)
+	require.NoError(t, err)
+	require.Equal(t, coins(sdk.DefaultBondDenom, 500), dividends)
+}
+
+func TestTokenFeeOfFailedMsg(t *testing.T) {
+	ownerPriv := secp256k1.GenPrivKey()
+	owner := sdk.AccAddress(ownerPriv.PubKey().Address())
+
+	genAccs := []authtypes.GenesisAccount{&authtypes.BaseAccount{Address: owner}}
+	app := SetupWithGenesisAccounts(genAccs, banktypes.Balance{
+		Address: owner,
+		Coins:   sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000000000000))),
+	})
+	txGen := MakeEncodingConfig().TxConfig
+
+	deliver := func(expPass bool, msgs ...sdk.Msg) {
+		ctx := app.BaseApp.NewContext(true, tmproto.Header{})
+		acc := app.AccountKeeper.GetAccount(ctx, owner)
+
+		header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+		_, _, _ = SignCheckDeliver(t, txGen, app.BaseApp, header, msgs, "", []uint64{acc.GetAccountNumber()}, []uint64{acc.GetSequence()}, expPass, expPass, ownerPriv)
+	}
+	balance := func() sdk.Coin {
+		return app.BankKeeper.GetBalance(app.BaseApp.NewContext(true, tmproto.Header{}), owner, sdk.DefaultBondDenom)
+	}
+
+	deliver(true, tokentypes.NewMsgIssueToken("btc", "satoshi", "Bitcoin Network", 0, sdk.NewInt(1000), sdk.NewInt(2000), true, owner))
+
+	// the minting fee is reverted along with the msg exceeding the max supply
+	before := balance()
+	deliver(false, tokentypes.NewMsgMintToken("btc", owner, nil, sdk.NewInt(5000)))
+	require.Equal(t, before, balance())
+
+	ctx := app.BaseApp.NewContext(true, tmproto.Header{})
+	mintFee := app.TokenKeeper.GetTokenMintFee(ctx, "btc")
+	deliver(true, tokentypes.NewMsgMintToken("btc", owner, nil, sdk.NewInt(500)))
+	require.Equal(t, before.Sub(mintFee), balance())
+}
+
+func TestTokenFeeAfterTransfers(t *testing.T) {
+	ownerPriv := secp256k1.GenPrivKey()
+	owner := sdk.AccAddress(ownerPriv.PubKey().Address())
+	holder := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	initCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000000000000)))
+	genAccs := []authtypes.GenesisAccount{&authtypes.BaseAccount{Address: owner}}
+	app := SetupWithGenesisAccounts(genAccs, banktypes.Balance{Address: owner, Coins: initCoins})
+	txGen := MakeEncodingConfig().TxConfig
+
+	// the balance sent away in the same tx can not pay the issuance fee
+	ctx := app.BaseApp.NewContext(true, tmproto.Header{})
+	acc := app.AccountKeeper.GetAccount(ctx, owner)
+	tx, err := helpers.GenTx(
+		txGen,
+		[]sdk.Msg{
+			banktypes.NewMsgSend(owner, holder, initCoins),
+			tokentypes.NewMsgIssueToken("btc", "satoshi", "Bitcoin Network", 0, sdk.NewInt(1000), sdk.NewInt(2000), true, owner),
+		},
+		sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 0)},
+		helpers.DefaultGenTxGas,
+		"",
+		[]uint64{acc.GetAccountNumber()},
+		[]uint64{acc.GetSequence()},
+		ownerPriv,
+	)
+	require.NoError(t, err)
+
+	_, _, err = app.Check(tx)
+	require.True(t, sdkerrors.ErrInsufficientFunds.Is(err), err)
+	require.Equal(t, initCoins, app.BankKeeper.GetAllBalances(app.BaseApp.NewContext(true, tmproto.Header{}), owner))
+}
@@ -86,6 +86,10 @@ import (
 
 const appName = "SimApp"
 
+// TokenStoreUpgrade is the name of the upgrade which migrates the token store to the version 2.
+// The name of a scheduled upgrade is fixed, so a later store version is upgraded to under a new name
+const TokenStoreUpgrade = "token-store-v2"
+
 var (
 	// DefaultNodeHome default home directories for the application daemon
 	DefaultNodeHome string
@@ -243,8 +247,12 @@ func NewSimApp(
 	app.AccountKeeper = authkeeper.NewAccountKeeper(
 		appCodec, keys[authtypes.StoreKey], app.GetSubspace(authtypes.ModuleName), authtypes.ProtoBaseAccount, maccPerms,
 	)
-	app.BankKeeper = bankkeeper.NewBaseKeeper(
-		appCodec, keys[banktypes.StoreKey], app.AccountKeeper, app.GetSubspace(banktypes.ModuleName), app.BlockedAddrs(),
+	// the transfers of the tokens are validated and their holder indexes are updated on every balance change
+	app.BankKeeper = tokenkeeper.NewHolderIndexBankKeeper(
+		bankkeeper.NewBaseKeeper(
+			appCodec, keys[banktypes.StoreKey], app.AccountKeeper, app.GetSubspace(banktypes.ModuleName), app.BlockedAddrs(),
+		),
+		app.AccountKeeper, keys[tokentypes.StoreKey], appCodec,
 	)
 	stakingKeeper := stakingkeeper.NewKeeper(
 		appCodec, keys[stakingtypes.StoreKey], app.AccountKeeper, app.BankKeeper, app.GetSubspace(stakingtypes.ModuleName),
@@ -315,6 +323,13 @@ func NewSimApp(
 
 	app.TokenKeeper = tokenkeeper.NewKeeper(appCodec, keys[tokentypes.StoreKey], app.GetSubspace(tokentypes.ModuleName), app.AccountKeeper, app.BankKeeper, authtypes.FeeCollectorName)
 
+	// migrate the token store in place on the upgrade
+	app.UpgradeKeeper.SetUpgradeHandler(TokenStoreUpgrade, func(ctx sdk.Context, plan upgradetypes.Plan) {
+		if err := tokenkeeper.NewMigrator(app.TokenKeeper).RunMigrations(ctx); err != nil {
+			panic(err)
+		}
+	})
+
 	// NOTE: Any module instantiated in the module manager that is later modified
 	// must be passed by reference here.
 	app.mm = module.NewManager(
@@ -0,0 +1,138 @@
+package simapp
+
+import (
+	"math"
+	"testing"
+
+	gogotypes "github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	tokenkeeper "github.com/irismod/token/keeper"
+	v1 "github.com/irismod/token/legacy/v1"
+	tokentypes "github.com/irismod/token/types"
+)
+
+func TestTokenStoreUpgrade(t *testing.T) {
+	app := Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 10})
+	require.Equal(t, tokentypes.ConsensusVersion, app.TokenKeeper.GetStoreVersion(ctx))
+
+	owner := sdk.AccAddress([]byte("tokenOwner"))
+	legacyTokens := []v1.Token{{
+		Symbol:        "btc",
+		Name:          "Bitcoin Network",
+		Scale:         8,
+		MinUnit:       "satoshi",
+		InitialSupply: 21000000,
+		MaxSupply:     math.MaxUint64,
+		Mintable:      true,
+		Owner:         owner,
+	}, {
+		Symbol:        "eth",
+		Name:          "Ethereum Network",
+		Scale:         18,
+		MinUnit:       "wei",
+		InitialSupply: 100000000,
+		MaxSupply:     100000000,
+		Owner:         owner,
+	}}
+
+	// the balances change before the store is downgraded, as the tokens can not be read in the legacy layout
+	holder := sdk.AccAddress(tmhash.SumTruncated([]byte("tokenHolder")))
+	coins := sdk.NewCoins(sdk.NewCoin("satoshi", sdk.NewInt(100)))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, tokentypes.ModuleName, coins))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, tokentypes.ModuleName, holder, coins))
+
+	// populate the store in the unversioned layout, with a missing and an orphaned index entry
+	store := ctx.KVStore(app.GetKey(tokentypes.StoreKey))
+	store.Delete(tokentypes.KeyStoreVersion)
+	for _, tokenI := range app.TokenKeeper.GetTokens(ctx, nil) {
+		store.Set(tokentypes.KeySymbol(tokenI.GetSymbol()), app.AppCodec().MustMarshalBinaryBare(&v1.Token{
+			Symbol:        tokenI.GetSymbol(),
+			Name:          tokenI.GetName(),
+			Scale:         tokenI.GetScale(),
+			MinUnit:       tokenI.GetMinUnit(),
+			InitialSupply: tokenI.GetInitialSupply().Uint64(),
+			MaxSupply:     tokenI.GetMaxSupply().Uint64(),
+			Mintable:      tokenI.GetMintable(),
+			Owner:         tokenI.GetOwner(),
+		}))
+	}
+	for _, token := range legacyTokens {
+		store.Set(tokentypes.KeySymbol(token.Symbol), app.AppCodec().MustMarshalBinaryBare(&token))
+	}
+	symbol := app.AppCodec().MustMarshalBinaryBare(&gogotypes.StringValue{Value: "btc"})
+	store.Set(tokentypes.KeyMinUint("satoshi"), symbol)
+	store.Set(tokentypes.KeyMinUint("bits"), symbol)
+	store.Set(tokentypes.KeyTokens(owner, "btc"), symbol)
+
+	// the holder indexes do not exist in the unversioned layout
+	var holderKeys [][]byte
+	for _, prefix := range [][]byte{tokentypes.PrefixHolders, tokentypes.PrefixHoldersByBalance, tokentypes.PrefixHolderCounts} {
+		it := sdk.KVStorePrefixIterator(store, prefix)
+		for ; it.Valid(); it.Next() {
+			holderKeys = append(holderKeys, it.Key())
+		}
+		it.Close()
+	}
+	for _, key := range holderKeys {
+		store.Delete(key)
+	}
+
+	// only the params of the unversioned layout are stored, with a changed tax rate kept by the migration
+	params := app.TokenKeeper.GetParamSet(ctx)
+	params.TokenTaxRate = sdk.NewDecWithPrec(7, 1)
+	app.TokenKeeper.SetParamSet(ctx, params)
+	paramStore := ctx.KVStore(app.GetKey(paramstypes.StoreKey))
+	for _, pair := range params.ParamSetPairs() {
+		switch string(pair.Key) {
+		case string(tokentypes.KeyTokenTaxRate), string(tokentypes.KeyIssueTokenBaseFee), string(tokentypes.KeyMintTokenFeeRatio):
+		default:
+			paramStore.Delete(append([]byte(tokentypes.DefaultParamspace+"/"), pair.Key...))
+		}
+	}
+
+	require.Equal(t, uint64(1), app.TokenKeeper.GetStoreVersion(ctx))
+
+	require.Equal(t, "token-store-v2", TokenStoreUpgrade)
+	app.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: TokenStoreUpgrade, Height: ctx.BlockHeight()})
+	require.Equal(t, tokentypes.ConsensusVersion, app.TokenKeeper.GetStoreVersion(ctx))
+
+	for _, legacyToken := range legacyTokens {
+		token, err := app.TokenKeeper.GetToken(ctx, legacyToken.MinUnit)
+		require.NoError(t, err)
+		require.Equal(t, v1.MigrateToken(legacyToken), *token.(*tokentypes.Token))
+	}
+	require.Len(t, app.TokenKeeper.GetTokens(ctx, owner), 2)
+	require.False(t, store.Has(tokentypes.KeyMinUint("bits")))
+
+	expectedParams := tokentypes.DefaultParams()
+	expectedParams.TokenTaxRate = params.TokenTaxRate
+	expectedParams.IssueTokenBaseFee = params.IssueTokenBaseFee
+	expectedParams.MintTokenFeeRatio = params.MintTokenFeeRatio
+	require.Equal(t, expectedParams, app.TokenKeeper.GetParamSet(ctx))
+
+	count, err := app.TokenKeeper.GetHolderCount(ctx, "btc")
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), count)
+
+	// the holders of the native token are not indexed
+	_, err = app.TokenKeeper.GetHolderCount(ctx, sdk.DefaultBondDenom)
+	require.True(t, tokentypes.ErrHoldersNotIndexed.Is(err))
+
+	res, broken := tokenkeeper.AllInvariants(app.TokenKeeper)(ctx)
+	require.False(t, broken, res)
+
+	// the migrated store is not migrated again
+	require.NoError(t, tokenkeeper.NewMigrator(app.TokenKeeper).RunMigrations(ctx))
+
+	// the store newer than the binary is rejected
+	app.TokenKeeper.SetStoreVersion(ctx, tokentypes.ConsensusVersion+1)
+	require.Error(t, tokenkeeper.NewMigrator(app.TokenKeeper).RunMigrations(ctx))
+}
@@ -1,6 +1,8 @@
 package cli
 
 import (
+	"strconv"
+
 	flag "github.com/spf13/pflag"
 
 	"github.com/irismod/token/types"
@@ -16,6 +18,19 @@ const (
 	FlagMintable      = "mintable"
 	FlagTo            = "to"
 	FlagAmount        = "amount"
+	FlagQuota         = "quota"
+	FlagExpiry        = "expiry"
+	FlagRecipient     = "recipient"
+	FlagDescription   = "description"
+	FlagWebsite       = "website"
+	FlagLogoURI       = "logo-uri"
+	FlagContentHash   = "content-hash"
+	FlagAllocation    = "allocation"
+	FlagFeeDenom      = "fee-denom"
+	FlagDeposit       = "deposit"
+	FlagWithin        = "within"
+	FlagSymbolPrefix  = "symbol-prefix"
+	FlagRetireHeight  = "retire-height"
 )
 
 var (
@@ -23,6 +38,13 @@ var (
 	FsEditToken          = flag.NewFlagSet("", flag.ContinueOnError)
 	FsTransferTokenOwner = flag.NewFlagSet("", flag.ContinueOnError)
 	FsMintToken          = flag.NewFlagSet("", flag.ContinueOnError)
+	FsBurnToken          = flag.NewFlagSet("", flag.ContinueOnError)
+	FsGrantMinter        = flag.NewFlagSet("", flag.ContinueOnError)
+	FsCommitBid          = flag.NewFlagSet("", flag.ContinueOnError)
+
+	FsQueryTokens           = flag.NewFlagSet("", flag.ContinueOnError)
+	FsQueryPendingTransfers = flag.NewFlagSet("", flag.ContinueOnError)
+	FsQueryVestingBalances  = flag.NewFlagSet("", flag.ContinueOnError)
 )
 
 func init() {
@@ -30,16 +52,42 @@ func init() {
 	FsIssueToken.String(FlagName, "", "the token name, e.g. IRIS Network")
 	FsIssueToken.String(FlagMinUnit, "", "the minimum unit name of the token, e.g. wei")
 	FsIssueToken.Uint8(FlagScale, 0, "the token decimal. The maximum value is 18")
-	FsIssueToken.Uint64(FlagInitialSupply, 0, "the initial supply of the token")
-	FsIssueToken.Uint64(FlagMaxSupply, types.MaximumMaxSupply, "the max supply of the token")
+	FsIssueToken.String(FlagInitialSupply, "0", "the initial supply of the token")
+	FsIssueToken.String(FlagMaxSupply, strconv.FormatUint(types.MaximumMaxSupply, 10), "the max supply of the token")
 	FsIssueToken.Bool(FlagMintable, false, "whether the token can be minted, default to false")
+	FsIssueToken.String(FlagDescription, "", "the description of the token")
+	FsIssueToken.String(FlagWebsite, "", "the official website of the token")
+	FsIssueToken.String(FlagLogoURI, "", "the uri of the token logo")
+	FsIssueToken.String(FlagContentHash, "", "the hash of the off-chain metadata document of the token")
+	FsIssueToken.String(FlagFeeDenom, "", "the whitelisted denom to pay the issuance fee in, default to the base fee denom")
+	FsIssueToken.StringArray(FlagAllocation, nil, "a part of the initial supply vesting linearly to a recipient, in the format of <recipient>,<amount>,<end-time>[,<cliff-time>] with the times in RFC3339 format. Can be repeated")
 
 	FsEditToken.String(FlagName, "[do-not-modify]", "the token name, e.g. IRIS Network")
-	FsEditToken.Uint64(FlagMaxSupply, 0, "the max supply of the token")
+	FsEditToken.String(FlagMaxSupply, "0", "the max supply of the token, left unchanged if 0")
 	FsEditToken.String(FlagMintable, "", "whether the token can be minted, default to false")
+	FsEditToken.String(FlagDescription, types.DoNotModify, "the description of the token")
+	FsEditToken.String(FlagWebsite, types.DoNotModify, "the official website of the token")
+	FsEditToken.String(FlagLogoURI, types.DoNotModify, "the uri of the token logo")
+	FsEditToken.String(FlagContentHash, types.DoNotModify, "the hash of the off-chain metadata document of the token")
 
 	FsTransferTokenOwner.String(FlagTo, "", "the new owner")
 
 	FsMintToken.String(FlagTo, "", "address of minting token to")
-	FsMintToken.Uint64(FlagAmount, 0, "amount of minting token")
+	FsMintToken.String(FlagAmount, "", "amount of minting token")
+	FsMintToken.String(FlagFeeDenom, "", "the whitelisted denom to pay the minting fee in, default to the base fee denom")
+
+	FsBurnToken.String(FlagAmount, "", "amount of burning token")
+
+	FsGrantMinter.String(FlagQuota, "", "the maximum amount of the token the minter can mint")
+	FsGrantMinter.String(FlagExpiry, "", "the time the authorization expires at in RFC3339 format, e.g. 2021-01-01T00:00:00Z, never expires if empty")
+
+	FsCommitBid.String(FlagDeposit, "", "the deposit escrowed for the bid, which must not be less than the bid. Default to the bid, a larger deposit hides the bid until revealed")
+
+	FsQueryTokens.String(FlagMintable, "", "only the mintable tokens if true, or only the non-mintable ones if false")
+	FsQueryTokens.String(FlagSymbolPrefix, "", "only the tokens whose symbols begin with the prefix")
+
+	FsQueryPendingTransfers.String(FlagSymbol, "", "the token symbol")
+	FsQueryPendingTransfers.String(FlagRecipient, "", "the new owner of the pending transfers")
+
+	FsQueryVestingBalances.String(FlagSymbol, "", "the token symbol")
 }
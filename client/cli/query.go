@@ -3,6 +3,7 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -26,8 +27,22 @@ func GetQueryCmd() *cobra.Command {
 	queryCmd.AddCommand(
 		getCmdQueryToken(),
 		getCmdQueryTokens(),
+		getCmdQuerySupply(),
+		getCmdQueryHolders(),
+		getCmdQueryHolderCount(),
+		getCmdQueryHistory(),
 		getCmdQueryFee(),
 		getCmdQueryParams(),
+		getCmdQueryFrozenAccounts(),
+		getCmdQueryMinters(),
+		getCmdQueryRoles(),
+		getCmdQueryPendingTransfers(),
+		getCmdQueryVestingBalances(),
+		getCmdQuerySnapshotBalance(),
+		getCmdQueryDividends(),
+		getCmdQueryAuction(),
+		getCmdQuerySymbolReservation(),
+		getCmdQueryExpiringTokens(),
 	)
 
 	return queryCmd
@@ -81,9 +96,10 @@ func getCmdQueryTokens() *cobra.Command {
 	cmd := &cobra.Command{
 		Use: "tokens [owner]",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Query token by the owner.
+			fmt.Sprintf(`Query a page of the tokens, optionally filtered by the owner, the mintable flag and the symbol prefix.
+A page holds up to 100 tokens unless the --limit is specified.
 Example:
-$ %s query token tokens <owner>
+$ %s query token tokens <owner> --mintable=true --symbol-prefix=<prefix> --limit=100
 `,
 				version.AppName,
 			),
@@ -96,20 +112,46 @@ $ %s query token tokens <owner>
 				return err
 			}
 
-			var owner sdk.AccAddress
+			var owner string
 
 			if len(args) > 0 {
-				owner, err = sdk.AccAddressFromBech32(args[0])
-				if err != nil {
+				if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
 					return err
 				}
+				owner = args[0]
+			}
+
+			m, err := cmd.Flags().GetString(FlagMintable)
+			if err != nil {
+				return err
+			}
+
+			mintable, err := types.ParseBool(m)
+			if err != nil {
+				return err
+			}
+
+			symbolPrefix, err := cmd.Flags().GetString(FlagSymbolPrefix)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
 			}
 
 			queryClient := types.NewQueryClient(clientCtx)
 
 			res, err := queryClient.Tokens(context.Background(), &types.QueryTokensRequest{
-				Owner: owner,
+				Owner:        owner,
+				Mintable:     mintable,
+				SymbolPrefix: symbolPrefix,
+				Pagination:   pageReq,
 			})
+			if err != nil {
+				return err
+			}
 
 			tokens := make([]types.TokenI, 0, len(res.Tokens))
 			for _, eviAny := range res.Tokens {
@@ -124,7 +166,186 @@ $ %s query token tokens <owner>
 			return clientCtx.PrintOutputLegacy(tokens)
 		},
 	}
+	cmd.Flags().AddFlagSet(FsQueryTokens)
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "tokens")
+
+	return cmd
+}
+
+// getCmdQuerySupply implements the query token supply command.
+func getCmdQuerySupply() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:  "supply [denom]",
+		Args: cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the total supply, max supply, remaining mintable amount, burned amount and module-held amount of a token by symbol or minUnit, in both the main unit and the min unit.
+Example:
+$ %s query token supply <denom>
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Supply(context.Background(), &types.QuerySupplyRequest{
+				Denom: args[0],
+			})
+
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// getCmdQueryHolders implements the query token holders command.
+func getCmdQueryHolders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:  "holders [denom]",
+		Args: cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the holders of a token by symbol or minUnit, ordered by balance from the largest.
+Example:
+$ %s query token holders <denom> --limit=10
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Holders(context.Background(), &types.QueryHoldersRequest{
+				Denom:      args[0],
+				Pagination: pageReq,
+			})
+
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "holders")
+
+	return cmd
+}
+
+// getCmdQueryHolderCount implements the query token holder count command.
+func getCmdQueryHolderCount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:  "holder-count [denom]",
+		Args: cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the number of the holders of a token by symbol or minUnit.
+Example:
+$ %s query token holder-count <denom>
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.HolderCount(context.Background(), &types.QueryHolderCountRequest{
+				Denom: args[0],
+			})
+
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// getCmdQueryHistory implements the query token history command.
+func getCmdQueryHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:  "history [symbol]",
+		Args: cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the recorded mutations of a token with the height, time, signer and the old and new values of the changed fields, from the earliest.
+Example:
+$ %s query token history <symbol> [--retire-height=<height>]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			retireHeight, err := cmd.Flags().GetInt64(FlagRetireHeight)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TokenHistory(context.Background(), &types.QueryTokenHistoryRequest{
+				Symbol:       args[0],
+				Pagination:   pageReq,
+				RetireHeight: retireHeight,
+			})
+
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+	cmd.Flags().Int64(FlagRetireHeight, 0, "the height the token was retired at, to query the history of the retired token")
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "history")
 
 	return cmd
 }
@@ -137,7 +358,7 @@ func getCmdQueryFee() *cobra.Command {
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Query the token related fees.
 Example:
-$ %s query token fee <symbol>
+$ %s query token fee <symbol> [--fee-denom=<denom>]
 `,
 				version.AppName,
 			),
@@ -155,11 +376,17 @@ $ %s query token fee <symbol>
 				return err
 			}
 
+			feeDenom, err := cmd.Flags().GetString(FlagFeeDenom)
+			if err != nil {
+				return err
+			}
+
 			// query token fees
 			queryClient := types.NewQueryClient(clientCtx)
 
 			res, err := queryClient.Fees(context.Background(), &types.QueryFeesRequest{
-				Symbol: symbol,
+				Symbol:   symbol,
+				FeeDenom: feeDenom,
 			})
 
 			if err != nil {
@@ -169,6 +396,7 @@ $ %s query token fee <symbol>
 			return clientCtx.PrintOutput(res)
 		},
 	}
+	cmd.Flags().String(FlagFeeDenom, "", "the whitelisted denom to price the fees in, default to the base fee denom")
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
@@ -209,3 +437,482 @@ $ %s query token params
 
 	return cmd
 }
+
+// getCmdQueryFrozenAccounts implements the query frozen accounts command.
+func getCmdQueryFrozenAccounts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:  "frozen-accounts [symbol]",
+		Args: cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the accounts frozen for a token.
+Example:
+$ %s query token frozen-accounts <symbol>
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+
+			if err != nil {
+				return err
+			}
+
+			if err := types.CheckSymbol(args[0]); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FrozenAccounts(context.Background(), &types.QueryFrozenAccountsRequest{
+				Symbol: args[0],
+			})
+
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// getCmdQueryMinters implements the query minters command.
+func getCmdQueryMinters() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:  "minters [symbol]",
+		Args: cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the minters of a token and their remaining quotas.
+Example:
+$ %s query token minters <symbol>
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+
+			if err != nil {
+				return err
+			}
+
+			if err := types.CheckSymbol(args[0]); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Minters(context.Background(), &types.QueryMintersRequest{
+				Symbol: args[0],
+			})
+
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// getCmdQueryPendingTransfers implements the query pending transfers command.
+func getCmdQueryPendingTransfers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:  "pending-transfers",
+		Args: cobra.NoArgs,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the pending owner transfers of the tokens, optionally filtered by symbol and recipient.
+Example:
+$ %s query token pending-transfers --symbol=<symbol> --recipient=<address>
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+
+			if err != nil {
+				return err
+			}
+
+			symbol, err := cmd.Flags().GetString(FlagSymbol)
+			if err != nil {
+				return err
+			}
+			if len(symbol) > 0 {
+				if err := types.CheckSymbol(symbol); err != nil {
+					return err
+				}
+			}
+
+			r, err := cmd.Flags().GetString(FlagRecipient)
+			if err != nil {
+				return err
+			}
+
+			var recipient sdk.AccAddress
+			if len(r) > 0 {
+				recipient, err = sdk.AccAddressFromBech32(r)
+				if err != nil {
+					return err
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PendingTransfers(context.Background(), &types.QueryPendingTransfersRequest{
+				Symbol:    symbol,
+				Recipient: recipient,
+			})
+
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsQueryPendingTransfers)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// getCmdQueryRoles implements the query roles command.
+func getCmdQueryRoles() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:  "roles [symbol]",
+		Args: cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the roles granted for a token.
+Example:
+$ %s query token roles <symbol>
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+
+			if err != nil {
+				return err
+			}
+
+			if err := types.CheckSymbol(args[0]); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Roles(context.Background(), &types.QueryRolesRequest{
+				Symbol: args[0],
+			})
+
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// getCmdQueryVestingBalances implements the query vesting balances command.
+func getCmdQueryVestingBalances() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:  "vesting-balances [recipient]",
+		Args: cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the vested and unvested balances of the initial supply allocated to a recipient.
+Example:
+$ %s query token vesting-balances <recipient> --symbol=<symbol>
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+
+			if err != nil {
+				return err
+			}
+
+			recipient, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			symbol, err := cmd.Flags().GetString(FlagSymbol)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.VestingBalances(context.Background(), &types.QueryVestingBalancesRequest{
+				Recipient: recipient,
+				Symbol:    symbol,
+			})
+
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsQueryVestingBalances)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// getCmdQuerySnapshotBalance implements the query snapshot balance command.
+func getCmdQuerySnapshotBalance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:  "snapshot-balance [symbol] [snapshot-id] [address]",
+		Args: cobra.ExactArgs(3),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the balance of a holder at a snapshot of a token.
+Example:
+$ %s query token snapshot-balance <symbol> <snapshot-id> <address>
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+
+			if err != nil {
+				return err
+			}
+
+			if err := types.CheckSymbol(args[0]); err != nil {
+				return err
+			}
+
+			snapshotID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			addr, err := sdk.AccAddressFromBech32(args[2])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SnapshotBalance(context.Background(), &types.QuerySnapshotBalanceRequest{
+				Symbol:     args[0],
+				SnapshotId: snapshotID,
+				Address:    addr,
+			})
+
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// getCmdQueryDividends implements the query dividends command.
+func getCmdQueryDividends() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:  "dividends [symbol] [holder]",
+		Args: cobra.ExactArgs(2),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the dividends of a token claimable by a holder.
+Example:
+$ %s query token dividends <symbol> <holder>
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+
+			if err != nil {
+				return err
+			}
+
+			if err := types.CheckSymbol(args[0]); err != nil {
+				return err
+			}
+
+			holder, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Dividends(context.Background(), &types.QueryDividendsRequest{
+				Symbol: args[0],
+				Holder: holder,
+			})
+
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// getCmdQueryAuction implements the query auction command.
+func getCmdQueryAuction() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:  "auction [symbol]",
+		Args: cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the ongoing auction of a short symbol and its bids.
+Example:
+$ %s query token auction <symbol>
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+
+			if err != nil {
+				return err
+			}
+
+			if err := types.CheckSymbol(args[0]); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Auction(context.Background(), &types.QueryAuctionRequest{
+				Symbol: args[0],
+			})
+
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// getCmdQuerySymbolReservation implements the query symbol reservation command.
+func getCmdQuerySymbolReservation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:  "reservation [symbol]",
+		Args: cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the reservation of a symbol won in an auction.
+Example:
+$ %s query token reservation <symbol>
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+
+			if err != nil {
+				return err
+			}
+
+			if err := types.CheckSymbol(args[0]); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SymbolReservation(context.Background(), &types.QuerySymbolReservationRequest{
+				Symbol: args[0],
+			})
+
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// getCmdQueryExpiringTokens implements the query expiring tokens command.
+func getCmdQueryExpiringTokens() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:  "expiring-tokens",
+		Args: cobra.NoArgs,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the tokens whose symbol leases expire within the given number of blocks.
+Example:
+$ %s query token expiring-tokens --within=<blocks>
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+
+			if err != nil {
+				return err
+			}
+
+			within, err := cmd.Flags().GetUint64(FlagWithin)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ExpiringTokens(context.Background(), &types.QueryExpiringTokensRequest{
+				Within: within,
+			})
+
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+	cmd.Flags().Uint64(FlagWithin, 17280, "the number of blocks from the current height within which the symbol leases expire")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -33,7 +34,27 @@ func NewTxCmd() *cobra.Command {
 		getCmdIssueToken(),
 		getCmdEditToken(),
 		getCmdMintToken(),
+		getCmdMultiMint(),
 		getCmdTransferTokenOwner(),
+		getCmdAcceptTokenOwner(),
+		getCmdCancelTransferTokenOwner(),
+		getCmdBurnToken(),
+		getCmdFreezeAccount(),
+		getCmdUnfreezeAccount(),
+		getCmdPauseToken(),
+		getCmdUnpauseToken(),
+		getCmdGrantMinter(),
+		getCmdRevokeMinter(),
+		getCmdGrantRole(),
+		getCmdRevokeRole(),
+		getCmdRetireToken(),
+		getCmdSnapshotToken(),
+		getCmdDistributeDividend(),
+		getCmdClaimDividend(),
+		getCmdCommitBid(),
+		getCmdRevealBid(),
+		getCmdRenewSymbol(),
+		getCmdReleaseVesting(),
 	)
 
 	return txCmd
@@ -46,7 +67,7 @@ func getCmdIssueToken() *cobra.Command {
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Issue a new token.
 Example:
-$ %s tx token issue --name="Kitty Token" --symbol="kitty" --min-unit="kitty" --scale=0 --initial-supply=100000000000 --max-supply=1000000000000 --mintable=true --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+$ %s tx token issue --name="Kitty Token" --symbol="kitty" --min-unit="kitty" --scale=0 --initial-supply=100000000000 --max-supply=1000000000000 --mintable=true --website="https://kitty.io" --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
 				version.AppName,
 			),
 		),
@@ -59,15 +80,42 @@ $ %s tx token issue --name="Kitty Token" --symbol="kitty" --min-unit="kitty" --s
 
 			owner := clientCtx.GetFromAddress()
 
+			initialSupply, err := parseInt(FlagInitialSupply, viper.GetString(FlagInitialSupply))
+			if err != nil {
+				return err
+			}
+
+			maxSupply, err := parseInt(FlagMaxSupply, viper.GetString(FlagMaxSupply))
+			if err != nil {
+				return err
+			}
+
 			msg := &types.MsgIssueToken{
 				Symbol:        viper.GetString(FlagSymbol),
 				Name:          viper.GetString(FlagName),
 				MinUnit:       viper.GetString(FlagMinUnit),
 				Scale:         uint32(viper.GetInt(FlagScale)),
-				InitialSupply: uint64(viper.GetInt(FlagInitialSupply)),
-				MaxSupply:     uint64(viper.GetInt(FlagMaxSupply)),
+				InitialSupply: initialSupply,
+				MaxSupply:     maxSupply,
 				Mintable:      viper.GetBool(FlagMintable),
 				Owner:         owner,
+				Description:   viper.GetString(FlagDescription),
+				Website:       viper.GetString(FlagWebsite),
+				LogoURI:       viper.GetString(FlagLogoURI),
+				ContentHash:   viper.GetString(FlagContentHash),
+				FeeDenom:      viper.GetString(FlagFeeDenom),
+			}
+
+			allocations, err := cmd.Flags().GetStringArray(FlagAllocation)
+			if err != nil {
+				return err
+			}
+			for _, a := range allocations {
+				allocation, err := parseAllocation(a)
+				if err != nil {
+					return err
+				}
+				msg.Allocations = append(msg.Allocations, allocation)
 			}
 
 			if err := msg.ValidateBasic(); err != nil {
@@ -78,7 +126,7 @@ $ %s tx token issue --name="Kitty Token" --symbol="kitty" --min-unit="kitty" --s
 
 			if !viper.GetBool(flags.FlagGenerateOnly) {
 				// query fee
-				fee, err1 := queryTokenFees(clientCtx, msg.Symbol)
+				fee, err1 := queryTokenFees(clientCtx, msg.Symbol, msg.FeeDenom)
 				if err1 != nil {
 					return fmt.Errorf("failed to query token issue fee: %s", err1.Error())
 				}
@@ -136,7 +184,10 @@ $ %s tx token edit <symbol> --name="Cat Token" --max-supply=100000000000 --minta
 			owner := clientCtx.GetFromAddress()
 
 			name := viper.GetString(FlagName)
-			maxSupply := uint64(viper.GetInt(FlagMaxSupply))
+			maxSupply, err := parseInt(FlagMaxSupply, viper.GetString(FlagMaxSupply))
+			if err != nil {
+				return err
+			}
 
 			mintable, err := types.ParseBool(viper.GetString(FlagMintable))
 			if err != nil {
@@ -144,6 +195,10 @@ $ %s tx token edit <symbol> --name="Cat Token" --max-supply=100000000000 --minta
 			}
 
 			msg := types.NewMsgEditToken(name, args[0], maxSupply, mintable, owner)
+			msg.Description = viper.GetString(FlagDescription)
+			msg.Website = viper.GetString(FlagWebsite)
+			msg.LogoURI = viper.GetString(FlagLogoURI)
+			msg.ContentHash = viper.GetString(FlagContentHash)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -178,7 +233,10 @@ $ %s tx token mint <symbol> --amount=<amount> --to=<to> --from=<key-name> --chai
 
 			owner := clientCtx.GetFromAddress()
 
-			amount := uint64(viper.GetInt64(FlagAmount))
+			amount, err := parseInt(FlagAmount, viper.GetString(FlagAmount))
+			if err != nil {
+				return err
+			}
 
 			var to sdk.AccAddress
 			addr := viper.GetString(FlagTo)
@@ -192,6 +250,7 @@ $ %s tx token mint <symbol> --amount=<amount> --to=<to> --from=<key-name> --chai
 			msg := types.NewMsgMintToken(
 				args[0], owner, to, amount,
 			)
+			msg.FeeDenom = viper.GetString(FlagFeeDenom)
 
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -201,7 +260,7 @@ $ %s tx token mint <symbol> --amount=<amount> --to=<to> --from=<key-name> --chai
 
 			if !viper.GetBool(flags.FlagGenerateOnly) {
 				// query fee
-				fee, err1 := queryTokenFees(clientCtx, args[0])
+				fee, err1 := queryTokenFees(clientCtx, args[0], msg.FeeDenom)
 				if err1 != nil {
 					return fmt.Errorf("failed to query token mint fee: %s", err1.Error())
 				}
@@ -233,12 +292,59 @@ $ %s tx token mint <symbol> --amount=<amount> --to=<to> --from=<key-name> --chai
 	return cmd
 }
 
+// getCmdMultiMint implements the multi-mint token command
+func getCmdMultiMint() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "multi-mint [symbol] [recipient:amount]...",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Mint tokens to multiple addresses in one message. The minting fee is charged once plus a fraction of it for each additional recipient.
+Example:
+$ %s tx token multi-mint <symbol> <address1>:<amount1> <address2>:<amount2> --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			owner := clientCtx.GetFromAddress()
+
+			recipients := make([]types.MintRecipient, 0, len(args)-1)
+			for _, arg := range args[1:] {
+				recipient, err := parseMintRecipient(arg)
+				if err != nil {
+					return err
+				}
+				recipients = append(recipients, recipient)
+			}
+
+			msg := types.NewMsgMultiMint(args[0], owner, recipients)
+			msg.FeeDenom = viper.GetString(FlagFeeDenom)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagFeeDenom, "", "the whitelisted denom to pay the minting fee in, default to the base fee denom")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // getCmdTransferTokenOwner implements the transfer token owner command
 func getCmdTransferTokenOwner() *cobra.Command {
 	cmd := &cobra.Command{
 		Use: "transfer [symbol]",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Transfer the owner of a token to a new owner.
+			fmt.Sprintf(`Transfer the owner of a token to a new owner. The transfer takes effect after the new owner accepts it.
 Example:
 $ %s tx token transfer <symbol> --to=<to> --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
 				version.AppName,
@@ -275,3 +381,802 @@ $ %s tx token transfer <symbol> --to=<to> --from=<key-name> --chain-id=<chain-id
 
 	return cmd
 }
+
+// getCmdAcceptTokenOwner implements the accept token owner command
+func getCmdAcceptTokenOwner() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "accept-owner [symbol]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Accept the pending transfer of the owner of a token.
+Example:
+$ %s tx token accept-owner <symbol> --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			owner := clientCtx.GetFromAddress()
+
+			msg := types.NewMsgAcceptTokenOwner(args[0], owner)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// getCmdCancelTransferTokenOwner implements the cancel transfer token owner command
+func getCmdCancelTransferTokenOwner() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "cancel-transfer [symbol]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel the pending transfer of the owner of a token.
+Example:
+$ %s tx token cancel-transfer <symbol> --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			owner := clientCtx.GetFromAddress()
+
+			msg := types.NewMsgCancelTransferTokenOwner(args[0], owner)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// getCmdBurnToken implements the burn token command
+func getCmdBurnToken() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "burn [symbol]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Burn some tokens held by the sender.
+Example:
+$ %s tx token burn <symbol> --amount=<amount> --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			sender := clientCtx.GetFromAddress()
+
+			amount, err := parseInt(FlagAmount, viper.GetString(FlagAmount))
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBurnToken(args[0], sender, amount)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FsBurnToken)
+	_ = cmd.MarkFlagRequired(FlagAmount)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// getCmdFreezeAccount implements the freeze account command
+func getCmdFreezeAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "freeze [symbol] [address]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Freeze the balance of an account for a token.
+Example:
+$ %s tx token freeze <symbol> <address> --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			owner := clientCtx.GetFromAddress()
+
+			addr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgFreezeAccount(args[0], owner, addr)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// getCmdUnfreezeAccount implements the unfreeze account command
+func getCmdUnfreezeAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "unfreeze [symbol] [address]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Unfreeze the balance of an account for a token.
+Example:
+$ %s tx token unfreeze <symbol> <address> --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			owner := clientCtx.GetFromAddress()
+
+			addr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUnfreezeAccount(args[0], owner, addr)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// getCmdPauseToken implements the pause token command
+func getCmdPauseToken() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "pause [symbol]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Pause a token, which halts its transfers, mints and burns.
+Example:
+$ %s tx token pause <symbol> --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			owner := clientCtx.GetFromAddress()
+
+			msg := types.NewMsgPauseToken(args[0], owner)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// getCmdUnpauseToken implements the unpause token command
+func getCmdUnpauseToken() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "unpause [symbol]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Unpause a paused token.
+Example:
+$ %s tx token unpause <symbol> --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			owner := clientCtx.GetFromAddress()
+
+			msg := types.NewMsgUnpauseToken(args[0], owner)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// getCmdGrantMinter implements the grant minter command
+func getCmdGrantMinter() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "grant-minter [symbol] [minter]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Authorize an address to mint a token up to a quota.
+Example:
+$ %s tx token grant-minter <symbol> <minter> --quota=<quota> --expiry=<expiry> --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			owner := clientCtx.GetFromAddress()
+
+			minter, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			quota, err := parseInt(FlagQuota, viper.GetString(FlagQuota))
+			if err != nil {
+				return err
+			}
+
+			var expiry *time.Time
+			if expiryStr := strings.TrimSpace(viper.GetString(FlagExpiry)); len(expiryStr) > 0 {
+				t, err := time.Parse(time.RFC3339, expiryStr)
+				if err != nil {
+					return err
+				}
+				expiry = &t
+			}
+
+			msg := types.NewMsgGrantMinter(args[0], owner, minter, quota, expiry)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FsGrantMinter)
+	_ = cmd.MarkFlagRequired(FlagQuota)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// getCmdRevokeMinter implements the revoke minter command
+func getCmdRevokeMinter() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "revoke-minter [symbol] [minter]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Revoke the authorization of a minter of a token.
+Example:
+$ %s tx token revoke-minter <symbol> <minter> --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			owner := clientCtx.GetFromAddress()
+
+			minter, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeMinter(args[0], owner, minter)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// getCmdGrantRole implements the grant role command
+func getCmdGrantRole() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "grant-role [symbol] [address] [role]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Grant a role of a token to an address. The role is one of admin, minter, metadata-editor and freezer.
+Example:
+$ %s tx token grant-role <symbol> <address> minter --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			owner := clientCtx.GetFromAddress()
+
+			addr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			role, err := types.ParseRole(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgGrantRole(args[0], owner, addr, role)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// getCmdRevokeRole implements the revoke role command
+func getCmdRevokeRole() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "revoke-role [symbol] [address] [role]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Revoke a role of a token from an address. The role is one of admin, minter, metadata-editor and freezer.
+Example:
+$ %s tx token revoke-role <symbol> <address> minter --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			owner := clientCtx.GetFromAddress()
+
+			addr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			role, err := types.ParseRole(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeRole(args[0], owner, addr, role)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// getCmdRetireToken implements the retire token command
+func getCmdRetireToken() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "retire [symbol]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Retire a token whose total supply is zero. The symbol can be re-registered after the retired symbol cooldown.
+Example:
+$ %s tx token retire <symbol> --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			owner := clientCtx.GetFromAddress()
+
+			msg := types.NewMsgRetireToken(args[0], owner)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// getCmdSnapshotToken implements the snapshot token command
+func getCmdSnapshotToken() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "snapshot [symbol]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Take a snapshot of the balances of the token holders. The id of the snapshot is emitted in the snapshot_token event.
+Example:
+$ %s tx token snapshot <symbol> --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			owner := clientCtx.GetFromAddress()
+
+			msg := types.NewMsgSnapshotToken(args[0], owner)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// getCmdDistributeDividend implements the distribute dividend command
+func getCmdDistributeDividend() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "distribute-dividend [symbol] [amount]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Distribute coins to the holders of a token in proportion to their balances. The holders claim the dividends with the claim-dividend command.
+Example:
+$ %s tx token distribute-dividend <symbol> <amount> --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			owner := clientCtx.GetFromAddress()
+
+			amount, err := sdk.ParseCoins(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDistributeDividend(args[0], owner, amount)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// getCmdClaimDividend implements the claim dividend command
+func getCmdClaimDividend() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "claim-dividend [symbol]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Claim the dividends of a token accrued by the sender.
+Example:
+$ %s tx token claim-dividend <symbol> --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			holder := clientCtx.GetFromAddress()
+
+			msg := types.NewMsgClaimDividend(args[0], holder)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// getCmdCommitBid implements the commit bid command
+func getCmdCommitBid() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "commit-bid [symbol] [amount] [salt]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Commit a sealed bid in the auction of a short symbol. Only the hash of the bid is submitted, along with a deposit which must cover the bid.
+Keep the amount and the salt to reveal the bid with the reveal-bid command once the commit period ends.
+Example:
+$ %s tx token commit-bid <symbol> <amount> <salt> --deposit=<deposit> --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			bidder := clientCtx.GetFromAddress()
+
+			amount, err := sdk.ParseCoin(args[1])
+			if err != nil {
+				return err
+			}
+
+			// the deposit defaults to the bid, which reveals the bid before the reveal period
+			deposit := amount
+			if depositStr := viper.GetString(FlagDeposit); len(depositStr) > 0 {
+				if deposit, err = sdk.ParseCoin(depositStr); err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgCommitBid(args[0], bidder, types.BidHash(args[0], bidder, amount, args[2]), deposit)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FsCommitBid)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// getCmdRevealBid implements the reveal bid command
+func getCmdRevealBid() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "reveal-bid [symbol] [amount] [salt]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Reveal a sealed bid in the auction of a short symbol during the reveal period.
+Example:
+$ %s tx token reveal-bid <symbol> <amount> <salt> --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			bidder := clientCtx.GetFromAddress()
+
+			amount, err := sdk.ParseCoin(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevealBid(args[0], bidder, amount, args[2])
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// getCmdRenewSymbol implements the renew symbol command
+func getCmdRenewSymbol() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "renew [symbol]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Renew the symbol lease of a token by a lease period, which is charged the same as the issuance. The renewal restores the privileges of the owner and the granted roles of a lapsed token, and can be sent by the owner or an admin.
+Example:
+$ %s tx token renew <symbol> --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			owner := clientCtx.GetFromAddress()
+
+			msg := types.NewMsgRenewSymbol(args[0], owner)
+			msg.FeeDenom = viper.GetString(FlagFeeDenom)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			var prompt = "The symbol renewal transaction will consume extra fee"
+
+			if !viper.GetBool(flags.FlagGenerateOnly) {
+				// query fee
+				fee, err1 := queryTokenFees(clientCtx, args[0], msg.FeeDenom)
+				if err1 != nil {
+					return fmt.Errorf("failed to query symbol renewal fee: %s", err1.Error())
+				}
+
+				// append renewal fee to prompt
+				renewFeeMainUnit := sdk.Coins{fee.IssueFee}.String()
+				prompt += fmt.Sprintf(": %s", renewFeeMainUnit)
+			}
+
+			// a confirmation is needed
+			prompt += "\nAre you sure to proceed?"
+			confirmed, err := input.GetConfirmation(prompt, bufio.NewReader(os.Stdin), cmd.ErrOrStderr())
+			if err != nil {
+				return err
+			}
+
+			if !confirmed {
+				return fmt.Errorf("operation aborted")
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagFeeDenom, "", "the whitelisted denom to pay the renewal fee in, default to the base fee denom")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// getCmdReleaseVesting implements the release vesting command
+func getCmdReleaseVesting() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "release-vesting [symbol]",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Release the vested coins of a token allocated to the sender.
+Example:
+$ %s tx token release-vesting <symbol> --from=<key-name> --chain-id=<chain-id> --fees=<fee>`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			recipient := clientCtx.GetFromAddress()
+
+			msg := types.NewMsgReleaseVesting(args[0], recipient)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -2,20 +2,81 @@ package cli
 
 import (
 	"context"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/irismod/token/types"
 )
 
-// queryTokenFees retrieves the fees of issuance and minting for the specified symbol
-func queryTokenFees(cliCtx client.Context, symbol string) (types.QueryFeesResponse, error) {
+// queryTokenFees retrieves the fees of issuance and minting for the specified symbol, priced in the fee denom if specified
+func queryTokenFees(cliCtx client.Context, symbol, feeDenom string) (types.QueryFeesResponse, error) {
 	queryClient := types.NewQueryClient(cliCtx)
 
-	resp, err := queryClient.Fees(context.Background(), &types.QueryFeesRequest{Symbol: symbol})
+	resp, err := queryClient.Fees(context.Background(), &types.QueryFeesRequest{Symbol: symbol, FeeDenom: feeDenom})
 	if err != nil {
 		return types.QueryFeesResponse{}, err
 	}
 
 	return *resp, err
 }
+
+// parseAllocation parses a vesting allocation in the format of <recipient>,<amount>,<end-time>[,<cliff-time>]
+func parseAllocation(s string) (allocation types.VestingAllocation, err error) {
+	parts := strings.Split(strings.TrimSpace(s), ",")
+	if len(parts) != 3 && len(parts) != 4 {
+		return allocation, fmt.Errorf("invalid allocation %s, expected <recipient>,<amount>,<end-time>[,<cliff-time>]", s)
+	}
+
+	if allocation.Recipient, err = sdk.AccAddressFromBech32(parts[0]); err != nil {
+		return allocation, err
+	}
+
+	if allocation.Amount, err = parseInt("amount", parts[1]); err != nil {
+		return allocation, err
+	}
+
+	if allocation.EndTime, err = time.Parse(time.RFC3339, parts[2]); err != nil {
+		return allocation, err
+	}
+
+	if len(parts) == 4 {
+		cliffTime, err := time.Parse(time.RFC3339, parts[3])
+		if err != nil {
+			return allocation, err
+		}
+		allocation.CliffTime = &cliffTime
+	}
+
+	return allocation, nil
+}
+
+// parseMintRecipient parses a minting recipient in the format of <address>:<amount>
+func parseMintRecipient(s string) (recipient types.MintRecipient, err error) {
+	parts := strings.Split(strings.TrimSpace(s), ":")
+	if len(parts) != 2 {
+		return recipient, fmt.Errorf("invalid recipient %s, expected <address>:<amount>", s)
+	}
+
+	if recipient.Address, err = sdk.AccAddressFromBech32(parts[0]); err != nil {
+		return recipient, err
+	}
+
+	if recipient.Amount, err = parseInt("amount", parts[1]); err != nil {
+		return recipient, err
+	}
+
+	return recipient, nil
+}
+
+// parseInt parses an integer amount in main units of the token
+func parseInt(name, s string) (sdk.Int, error) {
+	amount, ok := sdk.NewIntFromString(strings.TrimSpace(s))
+	if !ok {
+		return sdk.Int{}, fmt.Errorf("invalid %s %s, expected an integer", name, s)
+	}
+	return amount, nil
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 
 	"github.com/irismod/token/types"
@@ -20,12 +21,30 @@ func registerQueryRoutes(cliCtx client.Context, r *mux.Router) {
 		queryTokenHandlerFn(cliCtx),
 	).Methods("GET")
 
-	// Query tokens by owner
+	// Query a page of tokens filtered by owner, mintable and symbol prefix
 	r.HandleFunc(
 		fmt.Sprintf("/%s/tokens", types.ModuleName),
 		queryTokensHandlerFn(cliCtx),
 	).Methods("GET")
 
+	// Query token supply
+	r.HandleFunc(
+		fmt.Sprintf("/%s/tokens/{%s}/supply", types.ModuleName, RestParamDenom),
+		queryTokenSupplyHandlerFn(cliCtx),
+	).Methods("GET")
+
+	// Query a page of token holders ordered by balance
+	r.HandleFunc(
+		fmt.Sprintf("/%s/tokens/{%s}/holders", types.ModuleName, RestParamDenom),
+		queryTokenHoldersHandlerFn(cliCtx),
+	).Methods("GET")
+
+	// Query the number of token holders
+	r.HandleFunc(
+		fmt.Sprintf("/%s/tokens/{%s}/holder-count", types.ModuleName, RestParamDenom),
+		queryTokenHolderCountHandlerFn(cliCtx),
+	).Methods("GET")
+
 	// Query token fees
 	r.HandleFunc(
 		fmt.Sprintf("/%s/tokens/{%s}/fee", types.ModuleName, RestParamSymbol),
@@ -86,8 +105,30 @@ func queryTokensHandlerFn(cliCtx client.Context) http.HandlerFunc {
 			}
 		}
 
+		mintable, err := types.ParseBool(r.FormValue(RestParamMintable))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		_, page, limit, err := rest.ParseHTTPArgs(r)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
 		params := types.QueryTokensParams{
-			Owner: owner,
+			Owner:        owner,
+			Mintable:     mintable,
+			SymbolPrefix: r.FormValue(RestParamSymbolPrefix),
+		}
+
+		// all the tokens are returned unless a page is requested
+		if len(r.FormValue("page")) > 0 || len(r.FormValue("limit")) > 0 {
+			params.Pagination = &query.PageRequest{
+				Offset: uint64((page - 1) * limit),
+				Limit:  uint64(limit),
+			}
 		}
 
 		bz, err := cliCtx.LegacyAmino.MarshalJSON(params)
@@ -113,6 +154,110 @@ func queryTokensHandlerFn(cliCtx client.Context) http.HandlerFunc {
 	}
 }
 
+// queryTokenSupplyHandlerFn is the HTTP request handler to query token supply
+func queryTokenSupplyHandlerFn(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		params := types.QuerySupplyParams{
+			Denom: vars[RestParamDenom],
+		}
+
+		bz, err := cliCtx.LegacyAmino.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(
+			fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySupply), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// queryTokenHoldersHandlerFn is the HTTP request handler to query token holders
+func queryTokenHoldersHandlerFn(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		_, page, limit, err := rest.ParseHTTPArgs(r)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		params := types.QueryHoldersParams{
+			Denom: vars[RestParamDenom],
+			Pagination: &query.PageRequest{
+				Offset: uint64((page - 1) * limit),
+				Limit:  uint64(limit),
+			},
+		}
+
+		bz, err := cliCtx.LegacyAmino.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(
+			fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryHolders), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// queryTokenHolderCountHandlerFn is the HTTP request handler to query the number of token holders
+func queryTokenHolderCountHandlerFn(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		params := types.QueryHolderCountParams{
+			Denom: vars[RestParamDenom],
+		}
+
+		bz, err := cliCtx.LegacyAmino.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(
+			fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryHolderCount), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
 // queryTokenFeesHandlerFn is the HTTP request handler to query token fees
 func queryTokenFeesHandlerFn(cliCtx client.Context) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -125,7 +270,8 @@ func queryTokenFeesHandlerFn(cliCtx client.Context) http.HandlerFunc {
 		}
 
 		params := types.QueryTokenFeesParams{
-			Symbol: symbol,
+			Symbol:   symbol,
+			FeeDenom: r.FormValue(RestParamFeeDenom),
 		}
 
 		bz, err := cliCtx.LegacyAmino.MarshalJSON(params)
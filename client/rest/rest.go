@@ -6,14 +6,20 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
+
+	"github.com/irismod/token/types"
 )
 
 // Rest variable names
 // nolint
 const (
-	RestParamDenom  = "denom"
-	RestParamSymbol = "symbol"
-	RestParamOwner  = "owner"
+	RestParamDenom    = "denom"
+	RestParamSymbol   = "symbol"
+	RestParamOwner    = "owner"
+	RestParamFeeDenom = "fee_denom"
+
+	RestParamMintable     = "mintable"
+	RestParamSymbolPrefix = "symbol_prefix"
 )
 
 // RegisterHandlers registers token-related REST handlers to a router
@@ -29,17 +35,28 @@ type issueTokenReq struct {
 	Name          string         `json:"name"`
 	Scale         uint32         `json:"scale"`
 	MinUnit       string         `json:"min_unit"`
-	InitialSupply uint64         `json:"initial_supply"`
-	MaxSupply     uint64         `json:"max_supply"`
+	InitialSupply sdk.Int        `json:"initial_supply"`
+	MaxSupply     sdk.Int        `json:"max_supply"`
 	Mintable      bool           `json:"mintable"`
+	Description   string         `json:"description"`
+	Website       string         `json:"website"`
+	LogoURI       string         `json:"logo_uri"`
+	ContentHash   string         `json:"content_hash"`
+
+	Allocations []types.VestingAllocation `json:"allocations"`
 }
 
 type editTokenReq struct {
 	BaseReq   rest.BaseReq   `json:"base_req"`
 	Owner     sdk.AccAddress `json:"owner"` //  owner of the token
-	MaxSupply uint64         `json:"max_supply"`
+	MaxSupply sdk.Int        `json:"max_supply"`
 	Mintable  string         `json:"mintable"` // mintable of the token
 	Name      string         `json:"name"`
+	// the metadata of the token is left unchanged if omitted
+	Description *string `json:"description"`
+	Website     *string `json:"website"`
+	LogoURI     *string `json:"logo_uri"`
+	ContentHash *string `json:"content_hash"`
 }
 
 type transferTokenOwnerReq struct {
@@ -48,9 +65,20 @@ type transferTokenOwnerReq struct {
 	DstOwner sdk.AccAddress `json:"dst_owner"` // the new owner
 }
 
+type acceptTokenOwnerReq struct {
+	BaseReq  rest.BaseReq   `json:"base_req"`
+	DstOwner sdk.AccAddress `json:"dst_owner"` // the new owner
+}
+
 type mintTokenReq struct {
 	BaseReq rest.BaseReq   `json:"base_req"`
 	Owner   sdk.AccAddress `json:"owner"`  // the current owner address of the token
 	To      sdk.AccAddress `json:"to"`     // address of minting token to
-	Amount  uint64         `json:"amount"` // amount of minting token
+	Amount  sdk.Int        `json:"amount"` // amount of minting token
+}
+
+type burnTokenReq struct {
+	BaseReq rest.BaseReq   `json:"base_req"`
+	Sender  sdk.AccAddress `json:"sender"` // the address of burning token from
+	Amount  sdk.Int        `json:"amount"` // amount of burning token
 }
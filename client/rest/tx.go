@@ -8,6 +8,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 
 	"github.com/irismod/token/types"
@@ -32,11 +33,23 @@ func registerTxRoutes(cliCtx client.Context, r *mux.Router) {
 		transferOwnerHandlerFn(cliCtx),
 	).Methods("POST")
 
+	// accept owner
+	r.HandleFunc(
+		fmt.Sprintf("/%s/tokens/{%s}/accept-owner", types.ModuleName, RestParamSymbol),
+		acceptOwnerHandlerFn(cliCtx),
+	).Methods("POST")
+
 	// mint token
 	r.HandleFunc(
 		fmt.Sprintf("/%s/tokens/{%s}/mint", types.ModuleName, RestParamSymbol),
 		mintTokenHandlerFn(cliCtx),
 	).Methods("POST")
+
+	// burn token
+	r.HandleFunc(
+		fmt.Sprintf("/%s/tokens/{%s}/burn", types.ModuleName, RestParamSymbol),
+		burnTokenHandlerFn(cliCtx),
+	).Methods("POST")
 }
 
 func issueTokenHandlerFn(cliCtx client.Context) http.HandlerFunc {
@@ -61,6 +74,11 @@ func issueTokenHandlerFn(cliCtx client.Context) http.HandlerFunc {
 			MaxSupply:     req.MaxSupply,
 			Mintable:      req.Mintable,
 			Owner:         req.Owner,
+			Description:   req.Description,
+			Website:       req.Website,
+			LogoURI:       req.LogoURI,
+			ContentHash:   req.ContentHash,
+			Allocations:   req.Allocations,
 		}
 		if err := msg.ValidateBasic(); err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -92,8 +110,26 @@ func editTokenHandlerFn(cliCtx client.Context) http.HandlerFunc {
 			return
 		}
 
+		// the max supply is left unchanged if omitted
+		maxSupply := req.MaxSupply
+		if maxSupply.IsNil() {
+			maxSupply = sdk.ZeroInt()
+		}
+
 		// create the MsgEditToken message
-		msg := types.NewMsgEditToken(req.Name, symbol, req.MaxSupply, mintable, req.Owner)
+		msg := types.NewMsgEditToken(req.Name, symbol, maxSupply, mintable, req.Owner)
+		if req.Description != nil {
+			msg.Description = *req.Description
+		}
+		if req.Website != nil {
+			msg.Website = *req.Website
+		}
+		if req.LogoURI != nil {
+			msg.LogoURI = *req.LogoURI
+		}
+		if req.ContentHash != nil {
+			msg.ContentHash = *req.ContentHash
+		}
 		if err := msg.ValidateBasic(); err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
@@ -129,6 +165,32 @@ func transferOwnerHandlerFn(cliCtx client.Context) http.HandlerFunc {
 	}
 }
 
+func acceptOwnerHandlerFn(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		symbol := vars[RestParamSymbol]
+
+		var req acceptTokenOwnerReq
+		if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		// create the MsgAcceptTokenOwner message
+		msg := types.NewMsgAcceptTokenOwner(symbol, req.DstOwner)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(cliCtx, w, req.BaseReq, msg)
+	}
+}
+
 func mintTokenHandlerFn(cliCtx client.Context) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		vars := mux.Vars(r)
@@ -154,3 +216,29 @@ func mintTokenHandlerFn(cliCtx client.Context) http.HandlerFunc {
 		tx.WriteGeneratedTxResponse(cliCtx, w, req.BaseReq, msg)
 	}
 }
+
+func burnTokenHandlerFn(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		symbol := vars[RestParamSymbol]
+
+		var req burnTokenReq
+		if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		// create the MsgBurnToken message
+		msg := types.NewMsgBurnToken(symbol, req.Sender, req.Amount)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(cliCtx, w, req.BaseReq, msg)
+	}
+}
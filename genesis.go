@@ -2,6 +2,7 @@ package token
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/irismod/token/keeper"
 	"github.com/irismod/token/types"
@@ -14,6 +15,7 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
 	}
 
 	k.SetParamSet(ctx, data.Params)
+	k.SetStoreVersion(ctx, types.ConsensusVersion)
 
 	//init tokens
 	for _, token := range data.Tokens {
@@ -21,6 +23,69 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
 			panic(err.Error())
 		}
 	}
+
+	for _, coin := range data.BurnedCoins {
+		k.AddBurnCoin(ctx, coin)
+	}
+
+	for _, account := range data.FrozenAccounts {
+		k.SetFrozenAccount(ctx, account.Symbol, account.Address)
+	}
+
+	for _, minter := range data.Minters {
+		k.SetMinter(ctx, minter)
+	}
+
+	for _, transfer := range data.PendingTransfers {
+		k.SetPendingTransfer(ctx, transfer)
+	}
+
+	for _, role := range data.Roles {
+		k.SetRole(ctx, role)
+	}
+
+	for _, schedule := range data.VestingSchedules {
+		k.SetVestingSchedule(ctx, schedule)
+	}
+
+	for _, retired := range data.RetiredTokens {
+		k.SetRetiredToken(ctx, retired)
+	}
+
+	for _, snapshot := range data.Snapshots {
+		k.SetSnapshot(ctx, snapshot)
+	}
+
+	for _, balance := range data.SnapshotBalances {
+		k.SetSnapshotBalance(ctx, balance)
+	}
+
+	for _, index := range data.DividendIndexes {
+		k.SetDividendIndex(ctx, index)
+	}
+
+	for _, checkpoint := range data.DividendCheckpoints {
+		k.SetDividendCheckpoint(ctx, checkpoint)
+	}
+
+	for _, auction := range data.Auctions {
+		k.SetAuction(ctx, auction)
+	}
+
+	for _, bid := range data.Bids {
+		k.SetBid(ctx, bid)
+	}
+
+	for _, reservation := range data.SymbolReservations {
+		k.SetSymbolReservation(ctx, reservation)
+	}
+
+	for _, entry := range data.TokenHistory {
+		k.SetTokenHistoryEntry(ctx, entry)
+	}
+
+	// the holder indexes are derived from the bank balances, which are initialized before the token module
+	k.RebuildHolderIndexes(ctx)
 }
 
 // ExportGenesis - output genesis parameters
@@ -31,8 +96,23 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		tokens = append(tokens, *t)
 	}
 	return &types.GenesisState{
-		Params: k.GetParamSet(ctx),
-		Tokens: tokens,
+		Params:              k.GetParamSet(ctx),
+		Tokens:              tokens,
+		BurnedCoins:         k.GetAllBurnCoin(ctx),
+		FrozenAccounts:      k.GetAllFrozenAccounts(ctx),
+		Minters:             k.GetAllMinters(ctx),
+		PendingTransfers:    k.GetAllPendingTransfers(ctx),
+		Roles:               k.GetAllRoles(ctx),
+		VestingSchedules:    k.GetAllVestingSchedules(ctx),
+		RetiredTokens:       k.GetAllRetiredTokens(ctx),
+		Snapshots:           k.GetAllSnapshots(ctx),
+		SnapshotBalances:    k.GetAllSnapshotBalances(ctx),
+		DividendIndexes:     k.GetAllDividendIndexes(ctx),
+		DividendCheckpoints: k.GetAllDividendCheckpoints(ctx),
+		Auctions:            k.GetAllAuctions(ctx),
+		Bids:                k.GetAllBids(ctx),
+		SymbolReservations:  k.GetAllSymbolReservations(ctx),
+		TokenHistory:        k.GetAllTokenHistory(ctx),
 	}
 }
 
@@ -57,5 +137,191 @@ func ValidateGenesis(data types.GenesisState) error {
 			return err
 		}
 	}
+
+	// validate burned coins
+	for _, coin := range data.BurnedCoins {
+		if err := coin.Validate(); err != nil {
+			return err
+		}
+	}
+
+	// validate frozen accounts
+	for _, account := range data.FrozenAccounts {
+		if err := types.CheckSymbol(account.Symbol); err != nil {
+			return err
+		}
+		if account.Address.Empty() {
+			return sdkerrors.Wrapf(types.ErrInvalidAddress, "the frozen account of the token %s must be specified", account.Symbol)
+		}
+	}
+
+	// validate minters
+	for _, minter := range data.Minters {
+		if err := types.CheckSymbol(minter.Symbol); err != nil {
+			return err
+		}
+		if minter.Address.Empty() {
+			return sdkerrors.Wrapf(types.ErrInvalidAddress, "the minter of the token %s must be specified", minter.Symbol)
+		}
+		if minter.Quota.IsNil() || !minter.Quota.IsPositive() {
+			return sdkerrors.Wrapf(types.ErrInvalidAmount, "the quota of the minter %s must be positive", minter.Address)
+		}
+	}
+
+	// validate pending transfers
+	for _, transfer := range data.PendingTransfers {
+		if err := types.CheckSymbol(transfer.Symbol); err != nil {
+			return err
+		}
+		if transfer.SrcOwner.Empty() || transfer.DstOwner.Empty() {
+			return sdkerrors.Wrapf(types.ErrInvalidAddress, "the owners of the pending transfer of the token %s must be specified", transfer.Symbol)
+		}
+		if transfer.ExpireHeight < 0 {
+			return sdkerrors.Wrapf(types.ErrInvalidExpiry, "the expiration height of the pending transfer of the token %s must not be negative", transfer.Symbol)
+		}
+	}
+
+	// validate roles
+	for _, role := range data.Roles {
+		if err := types.CheckSymbol(role.Symbol); err != nil {
+			return err
+		}
+		if role.Address.Empty() {
+			return sdkerrors.Wrapf(types.ErrInvalidAddress, "the address granted the role of the token %s must be specified", role.Symbol)
+		}
+		if err := types.ValidateRole(role.Role); err != nil {
+			return err
+		}
+	}
+
+	// validate vesting schedules
+	for _, schedule := range data.VestingSchedules {
+		if err := schedule.Validate(); err != nil {
+			return err
+		}
+	}
+
+	// validate retired tokens
+	for _, retired := range data.RetiredTokens {
+		if err := types.CheckSymbol(retired.Symbol); err != nil {
+			return err
+		}
+		if len(retired.MinUnit) == 0 {
+			return sdkerrors.Wrapf(types.ErrInvalidMinUnit, "the min_unit of the retired token %s must be specified", retired.Symbol)
+		}
+		if retired.Owner.Empty() {
+			return sdkerrors.Wrapf(types.ErrInvalidAddress, "the owner of the retired token %s must be specified", retired.Symbol)
+		}
+		if retired.RetireHeight < 0 {
+			return sdkerrors.Wrapf(types.ErrInvalidExpiry, "the retirement height of the token %s must not be negative", retired.Symbol)
+		}
+	}
+
+	// validate snapshots
+	for _, snapshot := range data.Snapshots {
+		if err := types.CheckSymbol(snapshot.Symbol); err != nil {
+			return err
+		}
+		if snapshot.ID == 0 {
+			return sdkerrors.Wrapf(types.ErrSnapshotNotExists, "the id of the snapshot of the token %s must be positive", snapshot.Symbol)
+		}
+	}
+
+	// validate snapshot balances
+	for _, balance := range data.SnapshotBalances {
+		if err := types.CheckSymbol(balance.Symbol); err != nil {
+			return err
+		}
+		if balance.Address.Empty() {
+			return sdkerrors.Wrapf(types.ErrInvalidAddress, "the holder of the snapshot balance of the token %s must be specified", balance.Symbol)
+		}
+		if balance.Amount.IsNil() || balance.Amount.IsNegative() {
+			return sdkerrors.Wrapf(types.ErrInvalidAmount, "the snapshot balance of the holder %s must not be negative", balance.Address)
+		}
+	}
+
+	// validate dividend indexes
+	for _, index := range data.DividendIndexes {
+		if err := types.CheckSymbol(index.Symbol); err != nil {
+			return err
+		}
+		if err := index.Index.Validate(); err != nil {
+			return sdkerrors.Wrapf(types.ErrInvalidDividend, "invalid dividend index of the token %s: %s", index.Symbol, err)
+		}
+		if err := index.Reserve.Validate(); err != nil {
+			return sdkerrors.Wrapf(types.ErrInvalidDividend, "invalid dividend reserve of the token %s: %s", index.Symbol, err)
+		}
+	}
+
+	// validate dividend checkpoints
+	for _, checkpoint := range data.DividendCheckpoints {
+		if err := types.CheckSymbol(checkpoint.Symbol); err != nil {
+			return err
+		}
+		if checkpoint.Address.Empty() {
+			return sdkerrors.Wrapf(types.ErrInvalidAddress, "the holder of the dividend checkpoint of the token %s must be specified", checkpoint.Symbol)
+		}
+		if err := checkpoint.Index.Validate(); err != nil {
+			return sdkerrors.Wrapf(types.ErrInvalidDividend, "invalid dividend index of the holder %s: %s", checkpoint.Address, err)
+		}
+		if err := checkpoint.Accrued.Validate(); err != nil {
+			return sdkerrors.Wrapf(types.ErrInvalidDividend, "invalid accrued dividends of the holder %s: %s", checkpoint.Address, err)
+		}
+	}
+
+	// validate auctions
+	for _, auction := range data.Auctions {
+		if err := types.CheckSymbol(auction.Symbol); err != nil {
+			return err
+		}
+		if auction.CommitEndHeight < 0 || auction.RevealEndHeight < auction.CommitEndHeight {
+			return sdkerrors.Wrapf(types.ErrInvalidExpiry, "invalid bid periods of the auction of the symbol %s", auction.Symbol)
+		}
+	}
+
+	// validate bids
+	for _, bid := range data.Bids {
+		if err := types.CheckSymbol(bid.Symbol); err != nil {
+			return err
+		}
+		if bid.Bidder.Empty() {
+			return sdkerrors.Wrapf(types.ErrInvalidAddress, "the bidder in the auction of the symbol %s must be specified", bid.Symbol)
+		}
+		if err := types.ValidateBidHash(bid.BidHash); err != nil {
+			return err
+		}
+		if !bid.Deposit.IsValid() || bid.Deposit.Denom != bid.Amount.Denom || bid.Deposit.IsLT(bid.Amount) {
+			return sdkerrors.Wrapf(types.ErrInvalidBid, "invalid bid of %s in the auction of the symbol %s", bid.Bidder, bid.Symbol)
+		}
+	}
+
+	// validate symbol reservations
+	for _, reservation := range data.SymbolReservations {
+		if err := types.CheckSymbol(reservation.Symbol); err != nil {
+			return err
+		}
+		if reservation.Owner.Empty() {
+			return sdkerrors.Wrapf(types.ErrInvalidAddress, "the owner of the reservation of the symbol %s must be specified", reservation.Symbol)
+		}
+		if reservation.ExpireHeight < 0 {
+			return sdkerrors.Wrapf(types.ErrInvalidExpiry, "the expiration height of the reservation of the symbol %s must not be negative", reservation.Symbol)
+		}
+	}
+
+	// validate token history
+	for _, entry := range data.TokenHistory {
+		if err := types.CheckSymbol(entry.Symbol); err != nil {
+			return err
+		}
+		if entry.Sequence == 0 {
+			return sdkerrors.Wrapf(types.ErrInvalidTokenHistory, "the sequence of the history entry of the token %s must be positive", entry.Symbol)
+		}
+		if len(entry.Changes) == 0 {
+			return sdkerrors.Wrapf(types.ErrInvalidTokenHistory, "the history entry %d of the token %s must record changes", entry.Sequence, entry.Symbol)
+		}
+		if entry.RetireHeight < 0 {
+			return sdkerrors.Wrapf(types.ErrInvalidTokenHistory, "the retirement height of the history entry %d of the token %s must not be negative", entry.Sequence, entry.Symbol)
+		}
+	}
 	return nil
 }
@@ -35,7 +35,7 @@ func TestInitGenesis(t *testing.T) {
 
 	// add token
 	addr := sdk.AccAddress([]byte("addr1"))
-	ft := types.NewToken("btc", "Bitcoin Network", "satoshi", 1, 1, 1, true, addr)
+	ft := types.NewToken("btc", "Bitcoin Network", "satoshi", 1, sdk.NewInt(1), sdk.NewInt(1), true, addr)
 
 	genesis := types.GenesisState{
 		Params: types.DefaultParams(),
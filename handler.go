@@ -3,6 +3,8 @@ package token
 import (
 	"strconv"
 
+	"github.com/gogo/protobuf/proto"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -24,6 +26,46 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			return handleMsgMintToken(ctx, k, msg)
 		case *types.MsgTransferTokenOwner:
 			return handleMsgTransferTokenOwner(ctx, k, msg)
+		case *types.MsgAcceptTokenOwner:
+			return handleMsgAcceptTokenOwner(ctx, k, msg)
+		case *types.MsgCancelTransferTokenOwner:
+			return handleMsgCancelTransferTokenOwner(ctx, k, msg)
+		case *types.MsgBurnToken:
+			return handleMsgBurnToken(ctx, k, msg)
+		case *types.MsgFreezeAccount:
+			return handleMsgFreezeAccount(ctx, k, msg)
+		case *types.MsgUnfreezeAccount:
+			return handleMsgUnfreezeAccount(ctx, k, msg)
+		case *types.MsgPauseToken:
+			return handleMsgPauseToken(ctx, k, msg)
+		case *types.MsgUnpauseToken:
+			return handleMsgUnpauseToken(ctx, k, msg)
+		case *types.MsgGrantMinter:
+			return handleMsgGrantMinter(ctx, k, msg)
+		case *types.MsgRevokeMinter:
+			return handleMsgRevokeMinter(ctx, k, msg)
+		case *types.MsgMultiMint:
+			return handleMsgMultiMint(ctx, k, msg)
+		case *types.MsgGrantRole:
+			return handleMsgGrantRole(ctx, k, msg)
+		case *types.MsgRevokeRole:
+			return handleMsgRevokeRole(ctx, k, msg)
+		case *types.MsgRetireToken:
+			return handleMsgRetireToken(ctx, k, msg)
+		case *types.MsgSnapshotToken:
+			return handleMsgSnapshotToken(ctx, k, msg)
+		case *types.MsgDistributeDividend:
+			return handleMsgDistributeDividend(ctx, k, msg)
+		case *types.MsgClaimDividend:
+			return handleMsgClaimDividend(ctx, k, msg)
+		case *types.MsgCommitBid:
+			return handleMsgCommitBid(ctx, k, msg)
+		case *types.MsgRevealBid:
+			return handleMsgRevealBid(ctx, k, msg)
+		case *types.MsgRenewSymbol:
+			return handleMsgRenewSymbol(ctx, k, msg)
+		case *types.MsgReleaseVesting:
+			return handleMsgReleaseVesting(ctx, k, msg)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized nft message type: %T", msg)
 		}
@@ -33,7 +75,7 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 // handleIssueToken handles MsgIssueToken
 func handleIssueToken(ctx sdk.Context, k keeper.Keeper, msg *types.MsgIssueToken) (*sdk.Result, error) {
 	// handle fee for token
-	if err := k.DeductIssueTokenFee(ctx, msg.Owner, msg.Symbol); err != nil {
+	if err := k.DeductMsgTokenFee(ctx, msg); err != nil {
 		return nil, err
 	}
 
@@ -41,6 +83,30 @@ func handleIssueToken(ctx sdk.Context, k keeper.Keeper, msg *types.MsgIssueToken
 		return nil, err
 	}
 
+	tokenI, err := k.GetToken(ctx, msg.Symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	token := tokenI.(*types.Token)
+	if err := types.EmitTypedEvents(ctx, &types.EventIssueToken{
+		Symbol:            token.Symbol,
+		Name:              token.Name,
+		Scale:             token.Scale,
+		MinUnit:           token.MinUnit,
+		InitialSupply:     token.InitialSupply,
+		MaxSupply:         token.MaxSupply,
+		Mintable:          token.Mintable,
+		Owner:             token.Owner.String(),
+		Description:       token.Description,
+		Website:           token.Website,
+		LogoURI:           token.LogoURI,
+		ContentHash:       token.ContentHash,
+		LeaseExpireHeight: token.LeaseExpireHeight,
+	}); err != nil {
+		return nil, err
+	}
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeIssueToken,
@@ -57,10 +123,28 @@ func handleIssueToken(ctx sdk.Context, k keeper.Keeper, msg *types.MsgIssueToken
 
 // handleMsgEditToken handles MsgEditToken
 func handleMsgEditToken(ctx sdk.Context, k keeper.Keeper, msg *types.MsgEditToken) (*sdk.Result, error) {
+	oldToken, err := k.GetToken(ctx, msg.Symbol)
+	if err != nil {
+		return nil, err
+	}
+
 	if err := k.EditToken(ctx, *msg); err != nil {
 		return nil, err
 	}
 
+	token, err := k.GetToken(ctx, msg.Symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := types.EmitTypedEvents(ctx, &types.EventEditToken{
+		Symbol:  token.GetSymbol(),
+		Owner:   msg.Owner.String(),
+		Changes: types.TokenChanges(*oldToken.(*types.Token), *token.(*types.Token)),
+	}); err != nil {
+		return nil, err
+	}
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeEditToken,
@@ -81,10 +165,25 @@ func handleMsgTransferTokenOwner(ctx sdk.Context, k keeper.Keeper, msg *types.Ms
 		return nil, err
 	}
 
+	transfer, err := k.GetPendingTransfer(ctx, msg.Symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := types.EmitTypedEvents(ctx, &types.EventTransferTokenOwnerProposed{
+		Symbol:       transfer.Symbol,
+		SrcOwner:     transfer.SrcOwner.String(),
+		DstOwner:     transfer.DstOwner.String(),
+		ExpireHeight: transfer.ExpireHeight,
+	}); err != nil {
+		return nil, err
+	}
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeTransferTokenOwner,
 			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(types.AttributeKeyDstOwner, msg.DstOwner.String()),
 		),
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
@@ -96,9 +195,81 @@ func handleMsgTransferTokenOwner(ctx sdk.Context, k keeper.Keeper, msg *types.Ms
 	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
 }
 
+// handleMsgAcceptTokenOwner handles MsgAcceptTokenOwner
+func handleMsgAcceptTokenOwner(ctx sdk.Context, k keeper.Keeper, msg *types.MsgAcceptTokenOwner) (*sdk.Result, error) {
+	// the pending transfer is removed once accepted
+	transfer, err := k.GetPendingTransfer(ctx, msg.Symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.AcceptTokenOwner(ctx, *msg); err != nil {
+		return nil, err
+	}
+
+	if err := types.EmitTypedEvents(ctx, &types.EventTransferTokenOwner{
+		Symbol:   transfer.Symbol,
+		SrcOwner: transfer.SrcOwner.String(),
+		DstOwner: transfer.DstOwner.String(),
+	}); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAcceptTokenOwner,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(types.AttributeKeyDstOwner, msg.DstOwner.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DstOwner.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+// handleMsgCancelTransferTokenOwner handles MsgCancelTransferTokenOwner
+func handleMsgCancelTransferTokenOwner(ctx sdk.Context, k keeper.Keeper, msg *types.MsgCancelTransferTokenOwner) (*sdk.Result, error) {
+	// the pending transfer is removed once cancelled
+	transfer, err := k.GetPendingTransfer(ctx, msg.Symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.CancelTransferTokenOwner(ctx, *msg); err != nil {
+		return nil, err
+	}
+
+	if err := types.EmitTypedEvents(ctx, &types.EventCancelTransferTokenOwner{
+		Symbol:   transfer.Symbol,
+		SrcOwner: transfer.SrcOwner.String(),
+		DstOwner: transfer.DstOwner.String(),
+	}); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCancelTransferTokenOwner,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
 // handleMsgMintToken handles MsgMintToken
 func handleMsgMintToken(ctx sdk.Context, k keeper.Keeper, msg *types.MsgMintToken) (*sdk.Result, error) {
-	if err := k.DeductMintTokenFee(ctx, msg.Owner, msg.Symbol); err != nil {
+	// handle fee for token
+	if err := k.DeductMsgTokenFee(ctx, msg); err != nil {
 		return nil, err
 	}
 
@@ -106,11 +277,33 @@ func handleMsgMintToken(ctx sdk.Context, k keeper.Keeper, msg *types.MsgMintToke
 		return nil, err
 	}
 
+	tokenI, err := k.GetToken(ctx, msg.Symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	// an empty recipient mints to the owner
+	recipient := msg.To
+	if recipient.Empty() {
+		recipient = tokenI.GetOwner()
+	}
+
+	precision := sdk.NewIntWithDecimal(1, int(tokenI.GetScale()))
+	if err := types.EmitTypedEvents(ctx, &types.EventMintToken{
+		Symbol:    tokenI.GetSymbol(),
+		Minter:    msg.Owner.String(),
+		Recipient: recipient.String(),
+		Amount:    msg.Amount,
+		Coin:      sdk.NewCoin(tokenI.GetMinUnit(), msg.Amount.Mul(precision)),
+	}); err != nil {
+		return nil, err
+	}
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeMintToken,
 			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
-			sdk.NewAttribute(types.AttributeKeyAmount, strconv.FormatUint(msg.Amount, 10)),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
 		),
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
@@ -121,3 +314,444 @@ func handleMsgMintToken(ctx sdk.Context, k keeper.Keeper, msg *types.MsgMintToke
 
 	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
 }
+
+// handleMsgMultiMint handles MsgMultiMint
+func handleMsgMultiMint(ctx sdk.Context, k keeper.Keeper, msg *types.MsgMultiMint) (*sdk.Result, error) {
+	// handle fee for token
+	if err := k.DeductMsgTokenFee(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	if err := k.MultiMint(ctx, *msg); err != nil {
+		return nil, err
+	}
+
+	tokenI, err := k.GetToken(ctx, msg.Symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	// a typed event is emitted for each recipient as for a single mint
+	precision := sdk.NewIntWithDecimal(1, int(tokenI.GetScale()))
+	mintEvents := make([]proto.Message, len(msg.Recipients))
+	for i, recipient := range msg.Recipients {
+		mintEvents[i] = &types.EventMintToken{
+			Symbol:    tokenI.GetSymbol(),
+			Minter:    msg.Owner.String(),
+			Recipient: recipient.Address.String(),
+			Amount:    recipient.Amount,
+			Coin:      sdk.NewCoin(tokenI.GetMinUnit(), recipient.Amount.Mul(precision)),
+		}
+	}
+	if err := types.EmitTypedEvents(ctx, mintEvents...); err != nil {
+		return nil, err
+	}
+
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+		sdk.NewAttribute(types.AttributeKeyTotal, msg.TotalAmount().String()),
+	}
+	for _, recipient := range msg.Recipients {
+		attributes = append(attributes,
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipient.Address.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, recipient.Amount.String()),
+		)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(types.EventTypeMultiMintToken, attributes...),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+// handleMsgBurnToken handles MsgBurnToken
+func handleMsgBurnToken(ctx sdk.Context, k keeper.Keeper, msg *types.MsgBurnToken) (*sdk.Result, error) {
+	if err := k.BurnToken(ctx, *msg); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeBurnToken,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+// handleMsgFreezeAccount handles MsgFreezeAccount
+func handleMsgFreezeAccount(ctx sdk.Context, k keeper.Keeper, msg *types.MsgFreezeAccount) (*sdk.Result, error) {
+	if err := k.FreezeAccount(ctx, *msg); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeFreezeAccount,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(types.AttributeKeyAccount, msg.Address.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+// handleMsgUnfreezeAccount handles MsgUnfreezeAccount
+func handleMsgUnfreezeAccount(ctx sdk.Context, k keeper.Keeper, msg *types.MsgUnfreezeAccount) (*sdk.Result, error) {
+	if err := k.UnfreezeAccount(ctx, *msg); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUnfreezeAccount,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(types.AttributeKeyAccount, msg.Address.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+// handleMsgPauseToken handles MsgPauseToken
+func handleMsgPauseToken(ctx sdk.Context, k keeper.Keeper, msg *types.MsgPauseToken) (*sdk.Result, error) {
+	if err := k.PauseToken(ctx, *msg); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypePauseToken,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+// handleMsgUnpauseToken handles MsgUnpauseToken
+func handleMsgUnpauseToken(ctx sdk.Context, k keeper.Keeper, msg *types.MsgUnpauseToken) (*sdk.Result, error) {
+	if err := k.UnpauseToken(ctx, *msg); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUnpauseToken,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+// handleMsgGrantMinter handles MsgGrantMinter
+func handleMsgGrantMinter(ctx sdk.Context, k keeper.Keeper, msg *types.MsgGrantMinter) (*sdk.Result, error) {
+	if err := k.GrantMinter(ctx, *msg); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeGrantMinter,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(types.AttributeKeyMinter, msg.Minter.String()),
+			sdk.NewAttribute(types.AttributeKeyQuota, msg.Quota.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+// handleMsgRevokeMinter handles MsgRevokeMinter
+func handleMsgRevokeMinter(ctx sdk.Context, k keeper.Keeper, msg *types.MsgRevokeMinter) (*sdk.Result, error) {
+	if err := k.RevokeMinter(ctx, *msg); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRevokeMinter,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(types.AttributeKeyMinter, msg.Minter.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+// handleMsgGrantRole handles MsgGrantRole
+func handleMsgGrantRole(ctx sdk.Context, k keeper.Keeper, msg *types.MsgGrantRole) (*sdk.Result, error) {
+	if err := k.GrantRole(ctx, *msg); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeGrantRole,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(types.AttributeKeyAccount, msg.Address.String()),
+			sdk.NewAttribute(types.AttributeKeyRole, msg.Role.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+// handleMsgRevokeRole handles MsgRevokeRole
+func handleMsgRevokeRole(ctx sdk.Context, k keeper.Keeper, msg *types.MsgRevokeRole) (*sdk.Result, error) {
+	if err := k.RevokeRole(ctx, *msg); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRevokeRole,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(types.AttributeKeyAccount, msg.Address.String()),
+			sdk.NewAttribute(types.AttributeKeyRole, msg.Role.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+// handleMsgRetireToken handles MsgRetireToken
+func handleMsgRetireToken(ctx sdk.Context, k keeper.Keeper, msg *types.MsgRetireToken) (*sdk.Result, error) {
+	if err := k.RetireToken(ctx, *msg); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRetireToken,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+// handleMsgSnapshotToken handles MsgSnapshotToken
+func handleMsgSnapshotToken(ctx sdk.Context, k keeper.Keeper, msg *types.MsgSnapshotToken) (*sdk.Result, error) {
+	id, err := k.SnapshotToken(ctx, *msg)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSnapshotToken,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(types.AttributeKeySnapshot, strconv.FormatUint(id, 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+// handleMsgDistributeDividend handles MsgDistributeDividend
+func handleMsgDistributeDividend(ctx sdk.Context, k keeper.Keeper, msg *types.MsgDistributeDividend) (*sdk.Result, error) {
+	if err := k.DistributeDividend(ctx, *msg); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeDistributeDividend,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+// handleMsgClaimDividend handles MsgClaimDividend
+func handleMsgClaimDividend(ctx sdk.Context, k keeper.Keeper, msg *types.MsgClaimDividend) (*sdk.Result, error) {
+	claimed, err := k.ClaimDividend(ctx, *msg)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeClaimDividend,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(types.AttributeKeyRecipient, msg.Holder.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, claimed.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Holder.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+// handleMsgCommitBid handles MsgCommitBid
+func handleMsgCommitBid(ctx sdk.Context, k keeper.Keeper, msg *types.MsgCommitBid) (*sdk.Result, error) {
+	if err := k.CommitBid(ctx, *msg); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCommitBid,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(types.AttributeKeyBidder, msg.Bidder.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Bidder.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+// handleMsgRevealBid handles MsgRevealBid
+func handleMsgRevealBid(ctx sdk.Context, k keeper.Keeper, msg *types.MsgRevealBid) (*sdk.Result, error) {
+	if err := k.RevealBid(ctx, *msg); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRevealBid,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(types.AttributeKeyBidder, msg.Bidder.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Bidder.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+// handleMsgRenewSymbol handles MsgRenewSymbol
+func handleMsgRenewSymbol(ctx sdk.Context, k keeper.Keeper, msg *types.MsgRenewSymbol) (*sdk.Result, error) {
+	// handle fee for token
+	if err := k.DeductMsgTokenFee(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	expireHeight, err := k.RenewSymbol(ctx, *msg)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRenewSymbol,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(types.AttributeKeyExpire, strconv.FormatInt(expireHeight, 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+// handleMsgReleaseVesting handles MsgReleaseVesting
+func handleMsgReleaseVesting(ctx sdk.Context, k keeper.Keeper, msg *types.MsgReleaseVesting) (*sdk.Result, error) {
+	released, err := k.ReleaseVesting(ctx, *msg)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeReleaseVesting,
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+			sdk.NewAttribute(types.AttributeKeyRecipient, msg.Recipient.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, released.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Recipient.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
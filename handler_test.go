@@ -3,8 +3,10 @@ package token_test
 import (
 	"testing"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/suite"
 
+	abci "github.com/tendermint/tendermint/abci/types"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -64,25 +66,35 @@ func (suite *HandlerSuite) TestIssueToken() {
 
 	nativeTokenAmt1 := suite.bk.GetBalance(suite.ctx, owner, denom).Amount
 
-	msg := types.NewMsgIssueToken("btc", "satoshi", "Bitcoin Network", 18, 21000000, 21000000, false, owner)
+	msg := types.NewMsgIssueToken("btc", "satoshi", "Bitcoin Network", 18, sdk.NewInt(21000000), sdk.NewInt(21000000), false, owner)
 
-	_, err := h(suite.ctx, msg)
+	res, err := h(suite.ctx, msg)
 	suite.NoError(err)
 
+	suite.Equal(&types.EventIssueToken{
+		Symbol:        "btc",
+		Name:          "Bitcoin Network",
+		Scale:         18,
+		MinUnit:       "satoshi",
+		InitialSupply: sdk.NewInt(21000000),
+		MaxSupply:     sdk.NewInt(21000000),
+		Owner:         owner.String(),
+	}, suite.typedEvent(res, &types.EventIssueToken{}))
+
 	nativeTokenAmt2 := suite.bk.GetBalance(suite.ctx, owner, denom).Amount
 
 	fee := suite.keeper.GetTokenIssueFee(suite.ctx, msg.Symbol)
 
 	suite.Equal(nativeTokenAmt1.Sub(fee.Amount), nativeTokenAmt2)
 
-	mintTokenAmt := sdk.NewIntWithDecimal(int64(msg.InitialSupply), int(msg.Scale))
+	mintTokenAmt := msg.InitialSupply.Mul(sdk.NewIntWithDecimal(1, int(msg.Scale)))
 
 	nativeTokenAmt3 := suite.bk.GetBalance(suite.ctx, owner, msg.MinUnit).Amount
 	suite.Equal(nativeTokenAmt3, mintTokenAmt)
 }
 
 func (suite *HandlerSuite) TestMintToken() {
-	msg := types.NewMsgIssueToken("btc", "satoshi", "Bitcoin Network", 18, 1000, 2000, true, owner)
+	msg := types.NewMsgIssueToken("btc", "satoshi", "Bitcoin Network", 18, sdk.NewInt(1000), sdk.NewInt(2000), true, owner)
 
 	err := suite.keeper.IssueToken(suite.ctx, *msg)
 	suite.NoError(err)
@@ -90,22 +102,158 @@ func (suite *HandlerSuite) TestMintToken() {
 	suite.True(suite.keeper.HasToken(suite.ctx, msg.Symbol))
 
 	beginBtcAmt := suite.bk.GetBalance(suite.ctx, owner, msg.MinUnit).Amount
-	suite.Equal(sdk.NewIntWithDecimal(int64(msg.InitialSupply), int(msg.Scale)), beginBtcAmt)
+	suite.Equal(msg.InitialSupply.Mul(sdk.NewIntWithDecimal(1, int(msg.Scale))), beginBtcAmt)
 
 	beginNativeAmt := suite.bk.GetBalance(suite.ctx, owner, denom).Amount
 
 	h := token.NewHandler(suite.keeper)
 
-	msgMintToken := types.NewMsgMintToken(msg.Symbol, owner, nil, 1000)
-	_, err = h(suite.ctx, msgMintToken)
+	msgMintToken := types.NewMsgMintToken(msg.Symbol, owner, nil, sdk.NewInt(1000))
+	res, err := h(suite.ctx, msgMintToken)
 	suite.NoError(err)
 
+	// the empty recipient is the owner
+	suite.Equal(&types.EventMintToken{
+		Symbol:    "btc",
+		Minter:    owner.String(),
+		Recipient: owner.String(),
+		Amount:    sdk.NewInt(1000),
+		Coin:      sdk.NewCoin("satoshi", sdk.NewIntWithDecimal(1000, 18)),
+	}, suite.typedEvent(res, &types.EventMintToken{}))
+
 	endBtcAmt := suite.bk.GetBalance(suite.ctx, owner, msg.MinUnit).Amount
 
-	mintBtcAmt := sdk.NewIntWithDecimal(int64(msgMintToken.Amount), int(msg.Scale))
+	mintBtcAmt := msgMintToken.Amount.Mul(sdk.NewIntWithDecimal(1, int(msg.Scale)))
 	suite.Equal(beginBtcAmt.Add(mintBtcAmt), endBtcAmt)
 
 	fee := suite.keeper.GetTokenMintFee(suite.ctx, msg.Symbol)
 	endNativeAmt := suite.bk.GetBalance(suite.ctx, owner, denom).Amount
 	suite.Equal(beginNativeAmt.Sub(fee.Amount), endNativeAmt)
 }
+
+func (suite *HandlerSuite) TestEditToken() {
+	msg := types.NewMsgIssueToken("btc", "satoshi", "Bitcoin Network", 18, sdk.NewInt(1000), sdk.NewInt(2000), true, owner)
+	err := suite.keeper.IssueToken(suite.ctx, *msg)
+	suite.NoError(err)
+
+	h := token.NewHandler(suite.keeper)
+
+	msgEditToken := types.NewMsgEditToken("Bitcoin Token", "btc", sdk.NewInt(0), types.False, owner)
+	res, err := h(suite.ctx, msgEditToken)
+	suite.NoError(err)
+
+	suite.Equal(&types.EventEditToken{
+		Symbol: "btc",
+		Owner:  owner.String(),
+		Changes: []types.TokenChange{
+			{Field: "name", OldValue: "Bitcoin Network", NewValue: "Bitcoin Token"},
+			{Field: "mintable", OldValue: "true", NewValue: "false"},
+		},
+	}, suite.typedEvent(res, &types.EventEditToken{}))
+}
+
+func (suite *HandlerSuite) TestTransferTokenOwner() {
+	msg := types.NewMsgIssueToken("btc", "satoshi", "Bitcoin Network", 18, sdk.NewInt(1000), sdk.NewInt(2000), true, owner)
+	err := suite.keeper.IssueToken(suite.ctx, *msg)
+	suite.NoError(err)
+
+	h := token.NewHandler(suite.keeper)
+
+	dstOwner := sdk.AccAddress([]byte("TokenDstOwner"))
+	transferEvent := &types.EventTransferTokenOwner{Symbol: "btc", SrcOwner: owner.String(), DstOwner: dstOwner.String()}
+
+	// the request of the transfer changes no owner
+	res, err := h(suite.ctx, types.NewMsgTransferTokenOwner(owner, dstOwner, "btc"))
+	suite.NoError(err)
+	suite.Empty(suite.typedEvents(res.Events, &types.EventTransferTokenOwner{}))
+	suite.Equal(&types.EventTransferTokenOwnerProposed{
+		Symbol:       "btc",
+		SrcOwner:     owner.String(),
+		DstOwner:     dstOwner.String(),
+		ExpireHeight: suite.ctx.BlockHeight() + int64(suite.keeper.GetParamSet(suite.ctx).PendingTransferPeriod),
+	}, suite.typedEvent(res, &types.EventTransferTokenOwnerProposed{}))
+
+	res, err = h(suite.ctx, types.NewMsgCancelTransferTokenOwner("btc", owner))
+	suite.NoError(err)
+	suite.Equal(&types.EventCancelTransferTokenOwner{
+		Symbol:   "btc",
+		SrcOwner: owner.String(),
+		DstOwner: dstOwner.String(),
+	}, suite.typedEvent(res, &types.EventCancelTransferTokenOwner{}))
+
+	_, err = h(suite.ctx, types.NewMsgTransferTokenOwner(owner, dstOwner, "btc"))
+	suite.NoError(err)
+	res, err = h(suite.ctx, types.NewMsgAcceptTokenOwner("btc", dstOwner))
+	suite.NoError(err)
+	suite.Equal(transferEvent, suite.typedEvent(res, &types.EventTransferTokenOwner{}))
+
+	// the pending transfer expires in the end blocker
+	_, err = h(suite.ctx, types.NewMsgTransferTokenOwner(dstOwner, owner, "btc"))
+	suite.NoError(err)
+	transfer, err := suite.keeper.GetPendingTransfer(suite.ctx, "btc")
+	suite.NoError(err)
+
+	ctx := suite.ctx.WithBlockHeight(transfer.ExpireHeight).WithEventManager(sdk.NewEventManager())
+	token.EndBlocker(ctx, suite.keeper)
+	suite.Equal([]proto.Message{&types.EventExpireTransferTokenOwner{
+		Symbol:       "btc",
+		SrcOwner:     dstOwner.String(),
+		DstOwner:     owner.String(),
+		ExpireHeight: transfer.ExpireHeight,
+	}}, suite.typedEvents(ctx.EventManager().ABCIEvents(), &types.EventExpireTransferTokenOwner{}))
+}
+
+func (suite *HandlerSuite) TestMultiMint() {
+	msg := types.NewMsgIssueToken("btc", "satoshi", "Bitcoin Network", 18, sdk.NewInt(1000), sdk.NewInt(2000), true, owner)
+	err := suite.keeper.IssueToken(suite.ctx, *msg)
+	suite.NoError(err)
+
+	h := token.NewHandler(suite.keeper)
+
+	holder1, holder2 := sdk.AccAddress([]byte("tokenHolder1")), sdk.AccAddress([]byte("tokenHolder2"))
+	res, err := h(suite.ctx, types.NewMsgMultiMint("btc", owner, []types.MintRecipient{
+		{Address: holder1, Amount: sdk.NewInt(100)},
+		{Address: holder2, Amount: sdk.NewInt(200)},
+	}))
+	suite.NoError(err)
+
+	// a mint event is emitted for each recipient
+	precision := sdk.NewIntWithDecimal(1, int(msg.Scale))
+	suite.Equal([]proto.Message{
+		&types.EventMintToken{
+			Symbol:    "btc",
+			Minter:    owner.String(),
+			Recipient: holder1.String(),
+			Amount:    sdk.NewInt(100),
+			Coin:      sdk.NewCoin("satoshi", sdk.NewInt(100).Mul(precision)),
+		},
+		&types.EventMintToken{
+			Symbol:    "btc",
+			Minter:    owner.String(),
+			Recipient: holder2.String(),
+			Amount:    sdk.NewInt(200),
+			Coin:      sdk.NewCoin("satoshi", sdk.NewInt(200).Mul(precision)),
+		},
+	}, suite.typedEvents(res.Events, &types.EventMintToken{}))
+}
+
+// typedEvent returns the typed event of the same type as tev among the events of the result
+func (suite *HandlerSuite) typedEvent(res *sdk.Result, tev proto.Message) proto.Message {
+	parsed := suite.typedEvents(res.Events, tev)
+	if len(parsed) == 0 {
+		suite.FailNow("typed event not found", proto.MessageName(tev))
+	}
+	return parsed[0]
+}
+
+// typedEvents returns the typed events of the same type as tev among the events
+func (suite *HandlerSuite) typedEvents(events []abci.Event, tev proto.Message) (parsed []proto.Message) {
+	for _, event := range events {
+		if event.Type == proto.MessageName(tev) {
+			msg, err := types.ParseTypedEvent(event)
+			suite.Require().NoError(err)
+			parsed = append(parsed, msg)
+		}
+	}
+	return parsed
+}
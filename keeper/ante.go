@@ -1,66 +1,161 @@
 package keeper
 
 import (
+	"sort"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
-
-	"github.com/irismod/token/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
+// MsgWrapper defines a message which wraps other messages to be executed, such as an authz MsgExec
+type MsgWrapper interface {
+	GetMessages() ([]sdk.Msg, error)
+}
+
 type ValidateTokenFeeDecorator struct {
-	k  Keeper
-	ak types.AccountKeeper
-	bk types.BankKeeper
+	k Keeper
 }
 
-func NewValidateTokenFeeDecorator(k Keeper, ak types.AccountKeeper, bk types.BankKeeper) ValidateTokenFeeDecorator {
+func NewValidateTokenFeeDecorator(k Keeper) ValidateTokenFeeDecorator {
 	return ValidateTokenFeeDecorator{
-		k:  k,
-		ak: ak,
-		bk: bk,
+		k: k,
+	}
+}
+
+// AnteHandle returns an AnteHandler that checks the token related fees of all the msgs,
+// including the ones nested in the wrapper msgs, can be paid before any msg is executed.
+// The fees are aggregated by payer and denom, and the coins sent away by the bank msgs of the tx
+// are not counted as available to pay them. The handlers charge the fees along with the msgs,
+// so that a failed msg is charged nothing
+func (vtf ValidateTokenFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	feeMap := make(map[string]sdk.Coins)
+	outflows := make(map[string]sdk.Coins)
+	if err := vtf.collectFees(ctx, tx.GetMsgs(), feeMap, outflows); err != nil {
+		return ctx, err
+	}
+
+	// the payers are sorted so that the fees are checked in a deterministic order
+	payers := make([]string, 0, len(feeMap))
+	for payer := range feeMap {
+		payers = append(payers, payer)
+	}
+	sort.Strings(payers)
+
+	// the fees are deducted in a discarded branch of the state to check the whole fee handling
+	cacheCtx, _ := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+	for _, payer := range payers {
+		if err := vtf.checkOutflows(cacheCtx, sdk.AccAddress(payer), feeMap[payer], outflows[payer]); err != nil {
+			return ctx, err
+		}
+		if err := vtf.k.DeductTokenFees(cacheCtx, sdk.AccAddress(payer), feeMap[payer]); err != nil {
+			return ctx, err
+		}
 	}
+	// continue
+	return next(ctx, tx, simulate)
 }
 
-// AnteHandle returns an AnteHandler that checks if the balance of
-// the fee payer is sufficient for token related fee
-func (dtf ValidateTokenFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
-
-	// new ctx
-	newCtx = sdk.Context{}
-	// total fee
-	feeMap := make(map[string]sdk.Coin)
-	for _, msg := range tx.GetMsgs() {
-		// only check consecutive msgs which are routed to token from the beginning
-		if msg.Route() != types.ModuleName {
-			break
+// checkOutflows returns an error if the balance of the payer left by the bank msgs of the tx can not pay the fees
+func (vtf ValidateTokenFeeDecorator) checkOutflows(ctx sdk.Context, payer sdk.AccAddress, fees, outflows sdk.Coins) error {
+	for _, fee := range fees {
+		balance := vtf.k.bankKeeper.GetBalance(ctx, payer, fee.Denom)
+		if required := fee.Add(sdk.NewCoin(fee.Denom, outflows.AmountOf(fee.Denom))); balance.IsLT(required) {
+			return sdkerrors.Wrapf(
+				sdkerrors.ErrInsufficientFunds, "insufficient coins for token fee after the transfers of the tx; %s < %s", balance, required)
 		}
+	}
+	return nil
+}
 
+func (vtf ValidateTokenFeeDecorator) collectFees(ctx sdk.Context, msgs []sdk.Msg, feeMap, outflows map[string]sdk.Coins) error {
+	for _, msg := range msgs {
 		switch msg := msg.(type) {
-		case *types.MsgIssueToken:
-			fee := dtf.k.GetTokenIssueFee(ctx, msg.Symbol)
-			if fe, ok := feeMap[msg.Owner.String()]; ok {
-				feeMap[msg.Owner.String()] = fe.Add(fee)
-			} else {
-				feeMap[msg.Owner.String()] = fee
+		case MsgWrapper:
+			nested, err := msg.GetMessages()
+			if err != nil {
+				return err
+			}
+			if err := vtf.collectFees(ctx, nested, feeMap, outflows); err != nil {
+				return err
 			}
-		case *types.MsgMintToken:
-			fee := dtf.k.GetTokenMintFee(ctx, msg.Symbol)
-			if fe, ok := feeMap[msg.Owner.String()]; ok {
-				feeMap[msg.Owner.String()] = fe.Add(fee)
-			} else {
-				feeMap[msg.Owner.String()] = fee
+			continue
+		case *banktypes.MsgSend:
+			outflows[string(msg.FromAddress)] = outflows[string(msg.FromAddress)].Add(msg.Amount...)
+			continue
+		case *banktypes.MsgMultiSend:
+			for _, input := range msg.Inputs {
+				outflows[string(input.Address)] = outflows[string(input.Address)].Add(input.Coins...)
 			}
+			continue
 		}
-	}
 
-	for addr, fee := range feeMap {
-		owner, _ := sdk.AccAddressFromBech32(addr)
-		balance := dtf.bk.GetBalance(ctx, owner, fee.Denom)
-		if balance.IsLT(fee) {
-			return ctx, sdkerrors.Wrapf(
-				sdkerrors.ErrInsufficientFunds, "insufficient coins for token fee; %s < %s", balance, fee)
+		payer, fee, err := vtf.k.GetMsgTokenFee(ctx, msg)
+		if err != nil {
+			return err
+		}
+		if payer.Empty() {
+			continue
 		}
+
+		// the fees of a payer may be paid in different denoms
+		feeMap[string(payer)] = feeMap[string(payer)].Add(fee)
+	}
+	return nil
+}
+
+type ValidateTokenTransferDecorator struct {
+	k Keeper
+}
+
+func NewValidateTokenTransferDecorator(k Keeper) ValidateTokenTransferDecorator {
+	return ValidateTokenTransferDecorator{
+		k: k,
+	}
+}
+
+// AnteHandle returns an AnteHandler that rejects the bank transfers of a token, including the ones nested
+// in the wrapper msgs, which is paused, or from or to an account which is frozen for the token.
+// The transfers are checked again by the bank keeper wrapper, this only rejects them early in CheckTx
+func (vtd ValidateTokenTransferDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	if err := vtd.validateMsgs(ctx, tx.GetMsgs()); err != nil {
+		return ctx, err
 	}
 	// continue
 	return next(ctx, tx, simulate)
 }
+
+func (vtd ValidateTokenTransferDecorator) validateMsgs(ctx sdk.Context, msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		switch msg := msg.(type) {
+		case MsgWrapper:
+			nested, err := msg.GetMessages()
+			if err != nil {
+				return err
+			}
+			if err := vtd.validateMsgs(ctx, nested); err != nil {
+				return err
+			}
+		case *banktypes.MsgSend:
+			if err := vtd.k.ValidateTransfer(ctx, msg.FromAddress, msg.Amount); err != nil {
+				return err
+			}
+			if err := vtd.k.ValidateTransfer(ctx, msg.ToAddress, msg.Amount); err != nil {
+				return err
+			}
+		case *banktypes.MsgMultiSend:
+			for _, input := range msg.Inputs {
+				if err := vtd.k.ValidateTransfer(ctx, input.Address, input.Coins); err != nil {
+					return err
+				}
+			}
+			for _, output := range msg.Outputs {
+				if err := vtd.k.ValidateTransfer(ctx, output.Address, output.Coins); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,375 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	gogotypes "github.com/gogo/protobuf/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/irismod/token/types"
+)
+
+// GetAuction returns the auction of the specified symbol
+func (k Keeper) GetAuction(ctx sdk.Context, symbol string) (auction types.Auction, err error) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.KeyAuction(symbol))
+	if bz == nil {
+		return auction, sdkerrors.Wrap(types.ErrAuctionNotExists, fmt.Sprintf("auction of the symbol %s does not exist", symbol))
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &auction)
+	return auction, nil
+}
+
+// GetAllAuctions returns all the ongoing auctions
+func (k Keeper) GetAllAuctions(ctx sdk.Context) (auctions []types.Auction) {
+	store := ctx.KVStore(k.storeKey)
+
+	it := sdk.KVStorePrefixIterator(store, types.PrefixAuctions)
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		var auction types.Auction
+		k.cdc.MustUnmarshalBinaryBare(it.Value(), &auction)
+
+		auctions = append(auctions, auction)
+	}
+	return
+}
+
+// GetEndedAuctions returns the auctions whose reveal period ends at or before the specified height
+func (k Keeper) GetEndedAuctions(ctx sdk.Context, height int64) (auctions []types.Auction) {
+	store := ctx.KVStore(k.storeKey)
+
+	it := store.Iterator(types.PrefixAuctionQueue, sdk.PrefixEndBytes(types.KeyAuctionQueueByHeight(height)))
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		var symbol gogotypes.StringValue
+		k.cdc.MustUnmarshalBinaryBare(it.Value(), &symbol)
+
+		auction, err := k.GetAuction(ctx, symbol.Value)
+		if err != nil {
+			continue
+		}
+		auctions = append(auctions, auction)
+	}
+	return
+}
+
+// SetAuction saves the auction along with its index
+func (k Keeper) SetAuction(ctx sdk.Context, auction types.Auction) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshalBinaryBare(&auction)
+	store.Set(types.KeyAuction(auction.Symbol), bz)
+
+	symbol := k.cdc.MustMarshalBinaryBare(&gogotypes.StringValue{Value: auction.Symbol})
+	store.Set(types.KeyAuctionQueue(auction.RevealEndHeight, auction.Symbol), symbol)
+}
+
+func (k Keeper) deleteAuction(ctx sdk.Context, auction types.Auction) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Delete(types.KeyAuction(auction.Symbol))
+	store.Delete(types.KeyAuctionQueue(auction.RevealEndHeight, auction.Symbol))
+}
+
+// GetBid returns the bid of the bidder in the auction of the specified symbol
+func (k Keeper) GetBid(ctx sdk.Context, symbol string, bidder sdk.AccAddress) (bid types.Bid, err error) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.KeyBid(symbol, bidder))
+	if bz == nil {
+		return bid, sdkerrors.Wrap(types.ErrInvalidBid, fmt.Sprintf("bid of %s in the auction of the symbol %s does not exist", bidder, symbol))
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &bid)
+	return bid, nil
+}
+
+// GetBids returns the bids in the auction of the specified symbol
+func (k Keeper) GetBids(ctx sdk.Context, symbol string) (bids []types.Bid) {
+	store := ctx.KVStore(k.storeKey)
+
+	it := sdk.KVStorePrefixIterator(store, types.KeyBids(symbol))
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		var bid types.Bid
+		k.cdc.MustUnmarshalBinaryBare(it.Value(), &bid)
+
+		bids = append(bids, bid)
+	}
+	return
+}
+
+// GetAllBids returns the bids in all the ongoing auctions
+func (k Keeper) GetAllBids(ctx sdk.Context) (bids []types.Bid) {
+	store := ctx.KVStore(k.storeKey)
+
+	it := sdk.KVStorePrefixIterator(store, types.PrefixBids)
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		var bid types.Bid
+		k.cdc.MustUnmarshalBinaryBare(it.Value(), &bid)
+
+		bids = append(bids, bid)
+	}
+	return
+}
+
+// SetBid saves the bid
+func (k Keeper) SetBid(ctx sdk.Context, bid types.Bid) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&bid)
+
+	store.Set(types.KeyBid(bid.Symbol, bid.Bidder), bz)
+}
+
+// GetSymbolReservation returns the reservation of the specified symbol
+func (k Keeper) GetSymbolReservation(ctx sdk.Context, symbol string) (reservation types.SymbolReservation, err error) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.KeySymbolReservation(symbol))
+	if bz == nil {
+		return reservation, sdkerrors.Wrap(types.ErrAuctionNotExists, fmt.Sprintf("reservation of the symbol %s does not exist", symbol))
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &reservation)
+	return reservation, nil
+}
+
+// GetAllSymbolReservations returns all the symbol reservations
+func (k Keeper) GetAllSymbolReservations(ctx sdk.Context) (reservations []types.SymbolReservation) {
+	store := ctx.KVStore(k.storeKey)
+
+	it := sdk.KVStorePrefixIterator(store, types.PrefixSymbolReservations)
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		var reservation types.SymbolReservation
+		k.cdc.MustUnmarshalBinaryBare(it.Value(), &reservation)
+
+		reservations = append(reservations, reservation)
+	}
+	return
+}
+
+// GetExpiredSymbolReservations returns the symbol reservations which expire at or before the specified height
+func (k Keeper) GetExpiredSymbolReservations(ctx sdk.Context, height int64) (reservations []types.SymbolReservation) {
+	store := ctx.KVStore(k.storeKey)
+
+	it := store.Iterator(types.PrefixSymbolReservationQueue, sdk.PrefixEndBytes(types.KeySymbolReservationQueueByHeight(height)))
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		var symbol gogotypes.StringValue
+		k.cdc.MustUnmarshalBinaryBare(it.Value(), &symbol)
+
+		reservation, err := k.GetSymbolReservation(ctx, symbol.Value)
+		if err != nil {
+			continue
+		}
+		reservations = append(reservations, reservation)
+	}
+	return
+}
+
+// SetSymbolReservation saves the symbol reservation along with its index
+func (k Keeper) SetSymbolReservation(ctx sdk.Context, reservation types.SymbolReservation) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshalBinaryBare(&reservation)
+	store.Set(types.KeySymbolReservation(reservation.Symbol), bz)
+
+	symbol := k.cdc.MustMarshalBinaryBare(&gogotypes.StringValue{Value: reservation.Symbol})
+	store.Set(types.KeySymbolReservationQueue(reservation.ExpireHeight, reservation.Symbol), symbol)
+}
+
+// RemoveSymbolReservation deletes the symbol reservation along with its index
+func (k Keeper) RemoveSymbolReservation(ctx sdk.Context, reservation types.SymbolReservation) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Delete(types.KeySymbolReservation(reservation.Symbol))
+	store.Delete(types.KeySymbolReservationQueue(reservation.ExpireHeight, reservation.Symbol))
+}
+
+// IsAuctionedSymbol returns true if the symbol is shorter than the auction threshold and must be won in an auction
+func (k Keeper) IsAuctionedSymbol(ctx sdk.Context, symbol string) bool {
+	return uint32(len(strings.TrimSpace(symbol))) < k.GetParamSet(ctx).AuctionSymbolLength
+}
+
+// CommitBid escrows the deposit of a sealed bid in the auction of the symbol, which is started by the first bid
+func (k Keeper) CommitBid(ctx sdk.Context, msg types.MsgCommitBid) error {
+	symbol := strings.ToLower(msg.Symbol)
+	params := k.GetParamSet(ctx)
+
+	if !k.IsAuctionedSymbol(ctx, symbol) {
+		return sdkerrors.Wrapf(types.ErrInvalidBid, "the symbol %s is not shorter than %d and not auctioned", symbol, params.AuctionSymbolLength)
+	}
+
+	if k.HasToken(ctx, symbol) {
+		return sdkerrors.Wrapf(types.ErrSymbolAlreadyExists, "symbol already exists: %s", symbol)
+	}
+
+	if _, err := k.GetSymbolReservation(ctx, symbol); err == nil {
+		return sdkerrors.Wrapf(types.ErrSymbolReserved, "the symbol %s is reserved for the winner of the last auction", symbol)
+	}
+
+	if msg.Deposit.Denom != params.IssueTokenBaseFee.Denom {
+		return sdkerrors.Wrapf(types.ErrInvalidBid, "the deposit must be in %s", params.IssueTokenBaseFee.Denom)
+	}
+
+	auction, err := k.GetAuction(ctx, symbol)
+	if err != nil {
+		commitEndHeight := ctx.BlockHeight() + int64(params.BidCommitPeriod)
+		auction = types.Auction{
+			Symbol:          symbol,
+			CommitEndHeight: commitEndHeight,
+			RevealEndHeight: commitEndHeight + int64(params.BidRevealPeriod),
+		}
+		k.SetAuction(ctx, auction)
+	}
+
+	if ctx.BlockHeight() >= auction.CommitEndHeight {
+		return sdkerrors.Wrapf(types.ErrInvalidBid, "the commit period of the auction of the symbol %s ended at height %d", symbol, auction.CommitEndHeight)
+	}
+
+	if _, err := k.GetBid(ctx, symbol, msg.Bidder); err == nil {
+		return sdkerrors.Wrapf(types.ErrInvalidBid, "the bidder %s has committed a bid for the symbol %s", msg.Bidder, symbol)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, msg.Bidder, types.ModuleName, sdk.NewCoins(msg.Deposit)); err != nil {
+		return err
+	}
+
+	k.SetBid(ctx, types.Bid{
+		Symbol:  symbol,
+		Bidder:  msg.Bidder,
+		BidHash: strings.ToLower(msg.BidHash),
+		Deposit: msg.Deposit,
+		Amount:  sdk.NewCoin(msg.Deposit.Denom, sdk.ZeroInt()),
+	})
+	return nil
+}
+
+// RevealBid opens the sealed bid of the bidder during the reveal period of the auction
+func (k Keeper) RevealBid(ctx sdk.Context, msg types.MsgRevealBid) error {
+	symbol := strings.ToLower(msg.Symbol)
+
+	auction, err := k.GetAuction(ctx, symbol)
+	if err != nil {
+		return err
+	}
+
+	if ctx.BlockHeight() < auction.CommitEndHeight || ctx.BlockHeight() > auction.RevealEndHeight {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidBid, "the bids for the symbol %s can only be revealed between height %d and %d",
+			symbol, auction.CommitEndHeight, auction.RevealEndHeight,
+		)
+	}
+
+	bid, err := k.GetBid(ctx, symbol, msg.Bidder)
+	if err != nil {
+		return err
+	}
+
+	if bid.Revealed {
+		return sdkerrors.Wrapf(types.ErrInvalidBid, "the bid of %s for the symbol %s has been revealed", msg.Bidder, symbol)
+	}
+
+	if types.BidHash(symbol, msg.Bidder, msg.Amount, msg.Salt) != strings.ToLower(bid.BidHash) {
+		return sdkerrors.Wrapf(types.ErrInvalidBid, "the revealed bid does not match the committed hash %s", bid.BidHash)
+	}
+
+	if msg.Amount.Denom != bid.Deposit.Denom || bid.Deposit.IsLT(msg.Amount) {
+		return sdkerrors.Wrapf(types.ErrInvalidBid, "the bid %s exceeds the deposit %s", msg.Amount, bid.Deposit)
+	}
+
+	bid.Amount = msg.Amount
+	bid.Revealed = true
+	k.SetBid(ctx, bid)
+	return nil
+}
+
+// SettleAuction reserves the symbol for the highest revealed bid and refunds the other deposits.
+// The winning bid is charged like the issuing fee. It returns the winning bid, or nil if no bid is revealed
+func (k Keeper) SettleAuction(ctx sdk.Context, auction types.Auction) (*types.Bid, error) {
+	bids := k.GetBids(ctx, auction.Symbol)
+
+	// the first highest bid in the order of the bidder addresses wins
+	var winner *types.Bid
+	for i, bid := range bids {
+		if bid.Revealed && (winner == nil || winner.Amount.IsLT(bid.Amount)) {
+			winner = &bids[i]
+		}
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	for _, bid := range bids {
+		refund := bid.Deposit
+		if winner != nil && bid.Bidder.Equals(winner.Bidder) {
+			refund = refund.Sub(bid.Amount)
+		}
+
+		if refund.IsPositive() {
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, bid.Bidder, sdk.NewCoins(refund)); err != nil {
+				return nil, err
+			}
+		}
+
+		store.Delete(types.KeyBid(bid.Symbol, bid.Bidder))
+	}
+	k.deleteAuction(ctx, auction)
+
+	if winner == nil {
+		return nil, nil
+	}
+
+	if err := k.chargeWinningBid(ctx, winner.Amount); err != nil {
+		return nil, err
+	}
+
+	k.SetSymbolReservation(ctx, types.SymbolReservation{
+		Symbol:       auction.Symbol,
+		Owner:        winner.Bidder,
+		ExpireHeight: ctx.BlockHeight() + int64(k.GetParamSet(ctx).SymbolReservationPeriod),
+	})
+	return winner, nil
+}
+
+// checkSymbolReservation checks that the symbol is issued by the winner of its auction and consumes the reservation
+func (k Keeper) checkSymbolReservation(ctx sdk.Context, symbol string, owner sdk.AccAddress) error {
+	reservation, err := k.GetSymbolReservation(ctx, symbol)
+	if err != nil {
+		if k.IsAuctionedSymbol(ctx, symbol) {
+			return sdkerrors.Wrapf(types.ErrSymbolReserved, "the symbol %s must be won in an auction before being issued", symbol)
+		}
+		return nil
+	}
+
+	if !owner.Equals(reservation.Owner) {
+		return sdkerrors.Wrapf(types.ErrSymbolReserved, "the symbol %s is reserved for %s until height %d", symbol, reservation.Owner, reservation.ExpireHeight)
+	}
+
+	k.RemoveSymbolReservation(ctx, reservation)
+	return nil
+}
+
+// chargeWinningBid sends the community tax of the winning bid held by the module account to the fee collector and burns the rest
+func (k Keeper) chargeWinningBid(ctx sdk.Context, amount sdk.Coin) error {
+	communityTaxCoin := sdk.NewCoin(amount.Denom, sdk.NewDecFromInt(amount.Amount).Mul(k.GetParamSet(ctx).TokenTaxRate).TruncateInt())
+	burnedCoins := sdk.NewCoins(amount.Sub(communityTaxCoin))
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.feeCollectorName, sdk.NewCoins(communityTaxCoin)); err != nil {
+		return err
+	}
+
+	return k.bankKeeper.BurnCoins(ctx, types.ModuleName, burnedCoins)
+}
@@ -0,0 +1,292 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/irismod/token/types"
+)
+
+var _ bankkeeper.Keeper = HolderIndexBankKeeper{}
+
+// HolderIndexBankKeeper wraps the bank keeper to reject the transfers of the paused tokens and from or to the accounts frozen for the tokens,
+// to record the balances for the snapshots and settle the dividends of the tokens before the balances change,
+// and to update the holder indexes of the tokens after.
+// It must be used as the bank keeper of all the modules so that no balance change is missed
+type HolderIndexBankKeeper struct {
+	bankkeeper.Keeper
+
+	accountKeeper types.AccountKeeper
+	storeKey      sdk.StoreKey
+	cdc           codec.Marshaler
+}
+
+// NewHolderIndexBankKeeper returns a bank keeper which keeps the holder indexes in the specified token store
+func NewHolderIndexBankKeeper(bk bankkeeper.Keeper, ak types.AccountKeeper, key sdk.StoreKey, cdc codec.Marshaler) HolderIndexBankKeeper {
+	return HolderIndexBankKeeper{
+		Keeper:        bk,
+		accountKeeper: ak,
+		storeKey:      key,
+		cdc:           cdc,
+	}
+}
+
+// InputOutputCoins implements bankkeeper.Keeper
+func (bk HolderIndexBankKeeper) InputOutputCoins(ctx sdk.Context, inputs []banktypes.Input, outputs []banktypes.Output) error {
+	for _, input := range inputs {
+		if err := bk.validateTransfer(ctx, input.Coins, input.Address); err != nil {
+			return err
+		}
+	}
+	for _, output := range outputs {
+		if err := bk.validateTransfer(ctx, output.Coins, output.Address); err != nil {
+			return err
+		}
+	}
+
+	for _, input := range inputs {
+		bk.beforeBalancesChange(ctx, input.Coins, input.Address)
+	}
+	for _, output := range outputs {
+		bk.beforeBalancesChange(ctx, output.Coins, output.Address)
+	}
+
+	if err := bk.Keeper.InputOutputCoins(ctx, inputs, outputs); err != nil {
+		return err
+	}
+
+	for _, input := range inputs {
+		bk.afterBalancesChange(ctx, input.Coins, input.Address)
+	}
+	for _, output := range outputs {
+		bk.afterBalancesChange(ctx, output.Coins, output.Address)
+	}
+	return nil
+}
+
+// SendCoins implements bankkeeper.Keeper
+func (bk HolderIndexBankKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := bk.validateTransfer(ctx, amt, fromAddr, toAddr); err != nil {
+		return err
+	}
+
+	bk.beforeBalancesChange(ctx, amt, fromAddr, toAddr)
+	if err := bk.Keeper.SendCoins(ctx, fromAddr, toAddr, amt); err != nil {
+		return err
+	}
+
+	bk.afterBalancesChange(ctx, amt, fromAddr, toAddr)
+	return nil
+}
+
+// SubtractCoins implements bankkeeper.Keeper
+func (bk HolderIndexBankKeeper) SubtractCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Coins, error) {
+	bk.beforeBalancesChange(ctx, amt, addr)
+	balances, err := bk.Keeper.SubtractCoins(ctx, addr, amt)
+	if err != nil {
+		return balances, err
+	}
+
+	bk.afterBalancesChange(ctx, amt, addr)
+	return balances, nil
+}
+
+// AddCoins implements bankkeeper.Keeper
+func (bk HolderIndexBankKeeper) AddCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Coins, error) {
+	bk.beforeBalancesChange(ctx, amt, addr)
+	balances, err := bk.Keeper.AddCoins(ctx, addr, amt)
+	if err != nil {
+		return balances, err
+	}
+
+	bk.afterBalancesChange(ctx, amt, addr)
+	return balances, nil
+}
+
+// SetBalance implements bankkeeper.Keeper
+func (bk HolderIndexBankKeeper) SetBalance(ctx sdk.Context, addr sdk.AccAddress, balance sdk.Coin) error {
+	bk.beforeBalancesChange(ctx, sdk.Coins{balance}, addr)
+	if err := bk.Keeper.SetBalance(ctx, addr, balance); err != nil {
+		return err
+	}
+
+	bk.afterBalancesChange(ctx, sdk.Coins{balance}, addr)
+	return nil
+}
+
+// SetBalances implements bankkeeper.Keeper. The previous balances which are cleared are updated as well
+func (bk HolderIndexBankKeeper) SetBalances(ctx sdk.Context, addr sdk.AccAddress, balances sdk.Coins) error {
+	previous := bk.Keeper.GetAllBalances(ctx, addr)
+	bk.beforeBalancesChange(ctx, previous, addr)
+	bk.beforeBalancesChange(ctx, balances, addr)
+	if err := bk.Keeper.SetBalances(ctx, addr, balances); err != nil {
+		return err
+	}
+
+	bk.afterBalancesChange(ctx, previous, addr)
+	bk.afterBalancesChange(ctx, balances, addr)
+	return nil
+}
+
+// SendCoinsFromModuleToAccount implements bankkeeper.Keeper
+func (bk HolderIndexBankKeeper) SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := bk.validateTransfer(ctx, amt, recipientAddr); err != nil {
+		return err
+	}
+
+	bk.beforeBalancesChange(ctx, amt, authtypes.NewModuleAddress(senderModule), recipientAddr)
+	if err := bk.Keeper.SendCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt); err != nil {
+		return err
+	}
+
+	bk.afterBalancesChange(ctx, amt, authtypes.NewModuleAddress(senderModule), recipientAddr)
+	return nil
+}
+
+// SendCoinsFromModuleToModule implements bankkeeper.Keeper
+func (bk HolderIndexBankKeeper) SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error {
+	bk.beforeBalancesChange(ctx, amt, authtypes.NewModuleAddress(senderModule), authtypes.NewModuleAddress(recipientModule))
+	if err := bk.Keeper.SendCoinsFromModuleToModule(ctx, senderModule, recipientModule, amt); err != nil {
+		return err
+	}
+
+	bk.afterBalancesChange(ctx, amt, authtypes.NewModuleAddress(senderModule), authtypes.NewModuleAddress(recipientModule))
+	return nil
+}
+
+// SendCoinsFromAccountToModule implements bankkeeper.Keeper
+func (bk HolderIndexBankKeeper) SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	if err := bk.validateTransfer(ctx, amt, senderAddr); err != nil {
+		return err
+	}
+
+	bk.beforeBalancesChange(ctx, amt, senderAddr, authtypes.NewModuleAddress(recipientModule))
+	if err := bk.Keeper.SendCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt); err != nil {
+		return err
+	}
+
+	bk.afterBalancesChange(ctx, amt, senderAddr, authtypes.NewModuleAddress(recipientModule))
+	return nil
+}
+
+// DelegateCoinsFromAccountToModule implements bankkeeper.Keeper
+func (bk HolderIndexBankKeeper) DelegateCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	if err := bk.validateTransfer(ctx, amt, senderAddr); err != nil {
+		return err
+	}
+
+	bk.beforeBalancesChange(ctx, amt, senderAddr, authtypes.NewModuleAddress(recipientModule))
+	if err := bk.Keeper.DelegateCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt); err != nil {
+		return err
+	}
+
+	bk.afterBalancesChange(ctx, amt, senderAddr, authtypes.NewModuleAddress(recipientModule))
+	return nil
+}
+
+// UndelegateCoinsFromModuleToAccount implements bankkeeper.Keeper
+func (bk HolderIndexBankKeeper) UndelegateCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	bk.beforeBalancesChange(ctx, amt, authtypes.NewModuleAddress(senderModule), recipientAddr)
+	if err := bk.Keeper.UndelegateCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt); err != nil {
+		return err
+	}
+
+	bk.afterBalancesChange(ctx, amt, authtypes.NewModuleAddress(senderModule), recipientAddr)
+	return nil
+}
+
+// MintCoins implements bankkeeper.Keeper
+func (bk HolderIndexBankKeeper) MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error {
+	bk.beforeBalancesChange(ctx, amt, authtypes.NewModuleAddress(moduleName))
+	if err := bk.Keeper.MintCoins(ctx, moduleName, amt); err != nil {
+		return err
+	}
+
+	bk.afterBalancesChange(ctx, amt, authtypes.NewModuleAddress(moduleName))
+	return nil
+}
+
+// BurnCoins implements bankkeeper.Keeper
+func (bk HolderIndexBankKeeper) BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error {
+	bk.beforeBalancesChange(ctx, amt, authtypes.NewModuleAddress(moduleName))
+	if err := bk.Keeper.BurnCoins(ctx, moduleName, amt); err != nil {
+		return err
+	}
+
+	bk.afterBalancesChange(ctx, amt, authtypes.NewModuleAddress(moduleName))
+	return nil
+}
+
+// DelegateCoins implements bankkeeper.Keeper
+func (bk HolderIndexBankKeeper) DelegateCoins(ctx sdk.Context, delegatorAddr, moduleAccAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := bk.validateTransfer(ctx, amt, delegatorAddr); err != nil {
+		return err
+	}
+
+	bk.beforeBalancesChange(ctx, amt, delegatorAddr, moduleAccAddr)
+	if err := bk.Keeper.DelegateCoins(ctx, delegatorAddr, moduleAccAddr, amt); err != nil {
+		return err
+	}
+
+	bk.afterBalancesChange(ctx, amt, delegatorAddr, moduleAccAddr)
+	return nil
+}
+
+// UndelegateCoins implements bankkeeper.Keeper
+func (bk HolderIndexBankKeeper) UndelegateCoins(ctx sdk.Context, moduleAccAddr, delegatorAddr sdk.AccAddress, amt sdk.Coins) error {
+	bk.beforeBalancesChange(ctx, amt, moduleAccAddr, delegatorAddr)
+	if err := bk.Keeper.UndelegateCoins(ctx, moduleAccAddr, delegatorAddr, amt); err != nil {
+		return err
+	}
+
+	bk.afterBalancesChange(ctx, amt, moduleAccAddr, delegatorAddr)
+	return nil
+}
+
+// validateTransfer rejects the transfer of the coins of the paused tokens, or from or to the accounts which are frozen for the tokens.
+// The undelegations and the transfers between the module accounts are not validated,
+// so that the funds held by the other modules are always returned
+func (bk HolderIndexBankKeeper) validateTransfer(ctx sdk.Context, coins sdk.Coins, addrs ...sdk.AccAddress) error {
+	for _, addr := range addrs {
+		if err := bk.tokenKeeper().ValidateTransfer(ctx, addr, coins); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// tokenKeeper returns the token keeper reading the token store, which operates on the wrapped bank keeper
+func (bk HolderIndexBankKeeper) tokenKeeper() Keeper {
+	return Keeper{
+		storeKey:      bk.storeKey,
+		cdc:           bk.cdc,
+		accountKeeper: bk.accountKeeper,
+		bankKeeper:    bk.Keeper,
+	}
+}
+
+// beforeBalancesChange records the current balances of the addresses for the latest snapshots
+// and settles their dividends of the registered tokens among the coins
+func (bk HolderIndexBankKeeper) beforeBalancesChange(ctx sdk.Context, coins sdk.Coins, addrs ...sdk.AccAddress) {
+	for _, addr := range addrs {
+		bk.tokenKeeper().beforeBalanceChange(ctx, addr, coins)
+	}
+}
+
+// afterBalancesChange updates the holder indexes with the current balances of the addresses in the indexed tokens among the coins
+func (bk HolderIndexBankKeeper) afterBalancesChange(ctx sdk.Context, coins sdk.Coins, addrs ...sdk.AccAddress) {
+	store := ctx.KVStore(bk.storeKey)
+
+	for _, coin := range coins {
+		if !isHolderIndexed(store, coin.Denom) {
+			continue
+		}
+
+		for _, addr := range addrs {
+			setHolder(store, bk.cdc, addr, bk.Keeper.GetBalance(ctx, addr, coin.Denom))
+		}
+	}
+}
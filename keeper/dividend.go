@@ -0,0 +1,263 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/irismod/token/types"
+)
+
+// GetDividendIndex returns the cumulative dividends distributed per main unit of the specified token
+func (k Keeper) GetDividendIndex(ctx sdk.Context, symbol string) sdk.DecCoins {
+	return k.getDividendIndex(ctx, symbol).Index
+}
+
+// GetDividendReserve returns the dividends of the specified token held by the module account until claimed
+func (k Keeper) GetDividendReserve(ctx sdk.Context, symbol string) sdk.Coins {
+	return k.getDividendIndex(ctx, symbol).Reserve
+}
+
+func (k Keeper) getDividendIndex(ctx sdk.Context, symbol string) types.DividendIndex {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.KeyDividendIndex(symbol))
+	if bz == nil {
+		return types.DividendIndex{
+			Symbol:  symbol,
+			Index:   sdk.DecCoins{},
+			Reserve: sdk.Coins{},
+		}
+	}
+
+	var index types.DividendIndex
+	k.cdc.MustUnmarshalBinaryBare(bz, &index)
+	return index
+}
+
+// GetAllDividendIndexes returns the dividend indexes of all the tokens
+func (k Keeper) GetAllDividendIndexes(ctx sdk.Context) (indexes []types.DividendIndex) {
+	store := ctx.KVStore(k.storeKey)
+
+	it := sdk.KVStorePrefixIterator(store, types.PrefixDividendIndexes)
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		var index types.DividendIndex
+		k.cdc.MustUnmarshalBinaryBare(it.Value(), &index)
+
+		indexes = append(indexes, index)
+	}
+	return
+}
+
+// SetDividendIndex saves the dividend index
+func (k Keeper) SetDividendIndex(ctx sdk.Context, index types.DividendIndex) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&index)
+
+	store.Set(types.KeyDividendIndex(index.Symbol), bz)
+}
+
+// GetDividendCheckpoint returns the dividend checkpoint of the holder, which is empty if the holder
+// has not changed the balance since the first distribution
+func (k Keeper) GetDividendCheckpoint(ctx sdk.Context, symbol string, addr sdk.AccAddress) types.DividendCheckpoint {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.KeyDividendCheckpoint(symbol, addr))
+	if bz == nil {
+		return types.DividendCheckpoint{
+			Symbol:  symbol,
+			Address: addr,
+			Index:   sdk.DecCoins{},
+			Accrued: sdk.DecCoins{},
+		}
+	}
+
+	var checkpoint types.DividendCheckpoint
+	k.cdc.MustUnmarshalBinaryBare(bz, &checkpoint)
+	return checkpoint
+}
+
+// GetAllDividendCheckpoints returns the dividend checkpoints of all the tokens
+func (k Keeper) GetAllDividendCheckpoints(ctx sdk.Context) (checkpoints []types.DividendCheckpoint) {
+	store := ctx.KVStore(k.storeKey)
+
+	it := sdk.KVStorePrefixIterator(store, types.PrefixDividendCheckpoints)
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		var checkpoint types.DividendCheckpoint
+		k.cdc.MustUnmarshalBinaryBare(it.Value(), &checkpoint)
+
+		checkpoints = append(checkpoints, checkpoint)
+	}
+	return
+}
+
+// SetDividendCheckpoint saves the dividend checkpoint
+func (k Keeper) SetDividendCheckpoint(ctx sdk.Context, checkpoint types.DividendCheckpoint) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&checkpoint)
+
+	store.Set(types.KeyDividendCheckpoint(checkpoint.Symbol, checkpoint.Address), bz)
+}
+
+// DistributeDividend deposits the coins into the module account and distributes them
+// to the holders of the token in proportion to their balances
+func (k Keeper) DistributeDividend(ctx sdk.Context, msg types.MsgDistributeDividend) error {
+	tokenI, err := k.GetToken(ctx, msg.Symbol)
+	if err != nil {
+		return err
+	}
+
+	token := tokenI.(*types.Token)
+
+	if err := k.checkRole(ctx, *token, msg.Owner, types.RoleAdmin); err != nil {
+		return err
+	}
+
+	if msg.Amount.AmountOf(token.MinUnit).IsPositive() {
+		return sdkerrors.Wrapf(types.ErrInvalidDividend, "the token %s can not be distributed as its own dividend", msg.Symbol)
+	}
+
+	// the balance held by the module account, such as the unvested supply, takes no dividends
+	moduleBalance := k.bankKeeper.GetBalance(ctx, k.accountKeeper.GetModuleAddress(types.ModuleName), token.MinUnit)
+	shares := k.getDividendShares(*token, k.getTokenSupply(ctx, token.MinUnit).Sub(moduleBalance.Amount))
+	if !shares.IsPositive() {
+		return sdkerrors.Wrapf(types.ErrInvalidDividend, "the token %s has no holders", msg.Symbol)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, msg.Owner, types.ModuleName, msg.Amount); err != nil {
+		return err
+	}
+
+	index := k.getDividendIndex(ctx, token.Symbol)
+	index.Index = index.Index.Add(sdk.NewDecCoinsFromCoins(msg.Amount...).QuoDecTruncate(shares)...)
+	index.Reserve = index.Reserve.Add(msg.Amount...)
+	k.SetDividendIndex(ctx, index)
+	return nil
+}
+
+// ClaimDividend sends the dividends accrued by the holder and returns the claimed coins
+func (k Keeper) ClaimDividend(ctx sdk.Context, msg types.MsgClaimDividend) (sdk.Coins, error) {
+	tokenI, err := k.GetToken(ctx, msg.Symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	token := tokenI.(*types.Token)
+
+	checkpoint := k.settleDividend(ctx, *token, msg.Holder)
+
+	claimed, change := checkpoint.Accrued.TruncateDecimal()
+	if claimed.Empty() {
+		return nil, sdkerrors.Wrapf(types.ErrNoDividend, "the holder %s has no dividend of the token %s to claim", msg.Holder, msg.Symbol)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, msg.Holder, claimed); err != nil {
+		return nil, err
+	}
+
+	checkpoint.Accrued = change
+	k.SetDividendCheckpoint(ctx, checkpoint)
+
+	index := k.getDividendIndex(ctx, token.Symbol)
+	index.Reserve = index.Reserve.Sub(claimed)
+	k.SetDividendIndex(ctx, index)
+	return claimed, nil
+}
+
+// GetDividends returns the dividends of the token claimable by the holder
+func (k Keeper) GetDividends(ctx sdk.Context, symbol string, addr sdk.AccAddress) (sdk.Coins, error) {
+	tokenI, err := k.GetToken(ctx, symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	token := tokenI.(*types.Token)
+
+	claimable, _ := k.accrueDividend(ctx, *token, addr).Accrued.TruncateDecimal()
+	return claimable, nil
+}
+
+// settleDividends settles the dividends accrued by the holder of the given tokens
+func (k Keeper) settleDividends(ctx sdk.Context, addr sdk.AccAddress, coins sdk.Coins) {
+	for _, coin := range coins {
+		tokenI, err := k.GetToken(ctx, coin.Denom)
+		if err != nil || tokenI.GetMinUnit() != coin.Denom {
+			continue
+		}
+
+		k.settleDividend(ctx, *tokenI.(*types.Token), addr)
+	}
+}
+
+// settleDividend adds the dividends accrued by the current balance of the holder since the last checkpoint,
+// moves the checkpoint to the current index and returns it. It must be called before the balance changes
+func (k Keeper) settleDividend(ctx sdk.Context, token types.Token, addr sdk.AccAddress) types.DividendCheckpoint {
+	checkpoint := k.accrueDividend(ctx, token, addr)
+	if !checkpoint.Index.Empty() {
+		k.SetDividendCheckpoint(ctx, checkpoint)
+	}
+	return checkpoint
+}
+
+// accrueDividend returns the checkpoint of the holder moved to the current index without saving it
+func (k Keeper) accrueDividend(ctx sdk.Context, token types.Token, addr sdk.AccAddress) types.DividendCheckpoint {
+	checkpoint := k.GetDividendCheckpoint(ctx, token.Symbol, addr)
+
+	index := k.GetDividendIndex(ctx, token.Symbol)
+	if index.Empty() || addr.Equals(k.accountKeeper.GetModuleAddress(types.ModuleName)) {
+		return checkpoint
+	}
+
+	balance := k.bankKeeper.GetBalance(ctx, addr, token.MinUnit)
+	accrued := index.Sub(checkpoint.Index).MulDecTruncate(k.getDividendShares(token, balance.Amount))
+
+	checkpoint.Accrued = checkpoint.Accrued.Add(accrued...)
+	checkpoint.Index = index
+	return checkpoint
+}
+
+// payDividends sends the dividends of the token accrued by all the holders and removes the dividend state of the token.
+// The remainder of the reserve truncated from the dividends is sent to the fee collector
+func (k Keeper) payDividends(ctx sdk.Context, token types.Token) error {
+	store := ctx.KVStore(k.storeKey)
+	reserve := k.GetDividendReserve(ctx, token.Symbol)
+
+	var checkpoints []types.DividendCheckpoint
+	it := sdk.KVStorePrefixIterator(store, types.KeyDividendCheckpoints(token.Symbol))
+	for ; it.Valid(); it.Next() {
+		var checkpoint types.DividendCheckpoint
+		k.cdc.MustUnmarshalBinaryBare(it.Value(), &checkpoint)
+
+		checkpoints = append(checkpoints, checkpoint)
+	}
+	it.Close()
+
+	for _, checkpoint := range checkpoints {
+		claimable, _ := k.accrueDividend(ctx, token, checkpoint.Address).Accrued.TruncateDecimal()
+		if !claimable.Empty() {
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, checkpoint.Address, claimable); err != nil {
+				return err
+			}
+			reserve = reserve.Sub(claimable)
+		}
+
+		store.Delete(types.KeyDividendCheckpoint(token.Symbol, checkpoint.Address))
+	}
+
+	if !reserve.Empty() {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.feeCollectorName, reserve); err != nil {
+			return err
+		}
+	}
+
+	store.Delete(types.KeyDividendIndex(token.Symbol))
+	return nil
+}
+
+// getDividendShares returns the amount of the token in the main unit, by which the dividends are distributed
+func (k Keeper) getDividendShares(token types.Token, amount sdk.Int) sdk.Dec {
+	return sdk.NewDecFromIntWithPrec(amount, int64(token.Scale))
+}
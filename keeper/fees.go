@@ -6,6 +6,7 @@ import (
 	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/irismod/token/types"
 )
@@ -16,18 +17,83 @@ const (
 	FeeFactorExp  = 4
 )
 
-// DeductIssueTokenFee performs fee handling for issuing token
-func (k Keeper) DeductIssueTokenFee(ctx sdk.Context, owner sdk.AccAddress, symbol string) error {
-	// get the required issuance fee
-	fee := k.GetTokenIssueFee(ctx, symbol)
-	return feeHandler(ctx, k, owner, fee)
+// GetMsgTokenFee returns the payer and the token related fee of the msg in the chosen fee denom.
+// The payer is empty if the msg is charged no token fee
+func (k Keeper) GetMsgTokenFee(ctx sdk.Context, msg sdk.Msg) (payer sdk.AccAddress, fee sdk.Coin, err error) {
+	switch msg := msg.(type) {
+	case *types.MsgIssueToken:
+		fee, err = k.ConvertFee(ctx, k.GetTokenIssueFee(ctx, msg.Symbol), msg.FeeDenom)
+		return msg.Owner, fee, err
+	case *types.MsgMintToken:
+		fee, err = k.ConvertFee(ctx, k.GetTokenMintFee(ctx, msg.Symbol), msg.FeeDenom)
+		return msg.Owner, fee, err
+	case *types.MsgMultiMint:
+		fee, err = k.ConvertFee(ctx, k.GetTokenMultiMintFee(ctx, msg.Symbol, len(msg.Recipients)), msg.FeeDenom)
+		return msg.Owner, fee, err
+	case *types.MsgRenewSymbol:
+		// no renewal is charged while the symbol leases are disabled, the msg is rejected anyway
+		if k.GetParamSet(ctx).SymbolLeasePeriod == 0 {
+			return nil, fee, nil
+		}
+		// the renewal is charged the same as the issuance
+		fee, err = k.ConvertFee(ctx, k.GetTokenIssueFee(ctx, msg.Symbol), msg.FeeDenom)
+		return msg.Owner, fee, err
+	default:
+		return nil, fee, nil
+	}
+}
+
+// DeductMsgTokenFee deducts the token related fee of the msg from its payer.
+// It is called by the handler, so that the fee is reverted along with the msg if the msg fails
+func (k Keeper) DeductMsgTokenFee(ctx sdk.Context, msg sdk.Msg) error {
+	payer, fee, err := k.GetMsgTokenFee(ctx, msg)
+	if err != nil {
+		return err
+	}
+	if payer.Empty() {
+		return nil
+	}
+	return k.DeductTokenFees(ctx, payer, sdk.NewCoins(fee))
 }
 
-// DeductMintTokenFee performs fee handling for minting token
-func (k Keeper) DeductMintTokenFee(ctx sdk.Context, owner sdk.AccAddress, symbol string) error {
-	// get the required minting fee
-	fee := k.GetTokenMintFee(ctx, symbol)
-	return feeHandler(ctx, k, owner, fee)
+// DeductTokenFees performs fee handling for the token related fees of the payer, which may be paid in different denoms
+func (k Keeper) DeductTokenFees(ctx sdk.Context, payer sdk.AccAddress, fees sdk.Coins) error {
+	for _, fee := range fees {
+		balance := k.bankKeeper.GetBalance(ctx, payer, fee.Denom)
+		if balance.IsLT(fee) {
+			return sdkerrors.Wrapf(
+				sdkerrors.ErrInsufficientFunds, "insufficient coins for token fee; %s < %s", balance, fee)
+		}
+
+		if err := feeHandler(ctx, k, payer, fee); err != nil {
+			return err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDeductTokenFee,
+			sdk.NewAttribute(types.AttributeKeyPayer, payer.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, fees.String()),
+		),
+	)
+	return nil
+}
+
+// ConvertFee prices the fee in the specified fee denom at its conversion rate, rounding up.
+// An empty fee denom or the denom of the fee itself leaves the fee unchanged
+func (k Keeper) ConvertFee(ctx sdk.Context, fee sdk.Coin, feeDenom string) (sdk.Coin, error) {
+	if len(feeDenom) == 0 || feeDenom == fee.Denom {
+		return fee, nil
+	}
+
+	for _, accepted := range k.GetParamSet(ctx).FeeDenoms {
+		if accepted.Denom == feeDenom {
+			amount := sdk.NewDecFromInt(fee.Amount).Mul(accepted.Rate).Ceil().TruncateInt()
+			return sdk.NewCoin(feeDenom, amount), nil
+		}
+	}
+	return fee, sdkerrors.Wrapf(types.ErrInvalidFeeDenom, "the fee denom %s is not accepted", feeDenom)
 }
 
 // GetTokenIssueFee returns the token issurance fee
@@ -55,6 +121,26 @@ func (k Keeper) GetTokenMintFee(ctx sdk.Context, symbol string) sdk.Coin {
 	return k.truncateFee(ctx, issueFee.Denom, mintFee)
 }
 
+// GetTokenMultiMintFee returns the fee of minting token to the given number of recipients,
+// which charges the minting fee once plus MultiMintFeeRatio of it for each additional recipient
+func (k Keeper) GetTokenMultiMintFee(ctx sdk.Context, symbol string, recipients int) sdk.Coin {
+	// get params
+	params := k.GetParamSet(ctx)
+	mintTokenFeeRatio := params.MintTokenFeeRatio
+	multiMintFeeRatio := params.MultiMintFeeRatio
+
+	// compute the insurance and minting fees
+	issueFee := k.GetTokenIssueFee(ctx, symbol)
+	mintFee := sdk.NewDecFromInt(issueFee.Amount).Mul(mintTokenFeeRatio)
+
+	if recipients > 1 {
+		factor := sdk.OneDec().Add(multiMintFeeRatio.MulInt64(int64(recipients - 1)))
+		mintFee = mintFee.Mul(factor)
+	}
+
+	return k.truncateFee(ctx, issueFee.Denom, mintFee)
+}
+
 func (k Keeper) truncateFee(ctx sdk.Context, denom string, feeAmt sdk.Dec) sdk.Coin {
 	token, _ := k.GetToken(ctx, denom)
 	precision := sdk.NewIntWithDecimal(1, int(token.GetScale()))
@@ -69,10 +155,14 @@ func (k Keeper) truncateFee(ctx sdk.Context, denom string, feeAmt sdk.Dec) sdk.C
 	return sdk.NewCoin(token.GetMinUnit(), amount)
 }
 
-// feeHandler handles the fee of token
+// feeHandler handles the fee of token. The fee paid in a whitelisted fee denom
+// is not burned but sent to the fee collector as a whole
 func feeHandler(ctx sdk.Context, k Keeper, feeAcc sdk.AccAddress, fee sdk.Coin) error {
 	params := k.GetParamSet(ctx)
 	tokenTaxRate := params.TokenTaxRate
+	if fee.Denom != params.IssueTokenBaseFee.Denom {
+		tokenTaxRate = sdk.OneDec()
+	}
 
 	// compute community tax and burned coin
 	communityTaxCoin := sdk.NewCoin(fee.Denom, sdk.NewDecFromInt(fee.Amount).Mul(tokenTaxRate).TruncateInt())
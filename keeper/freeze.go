@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	gogotypes "github.com/gogo/protobuf/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/irismod/token/types"
+)
+
+// IsFrozenAccount returns true if the balance of the account is frozen for the specified symbol
+func (k Keeper) IsFrozenAccount(ctx sdk.Context, symbol string, addr sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.KeyFrozenAccount(symbol, addr))
+}
+
+// GetFrozenAccounts returns all the accounts frozen for the specified symbol
+func (k Keeper) GetFrozenAccounts(ctx sdk.Context, symbol string) (accounts []sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.KeyFrozenAccounts(symbol)
+
+	it := sdk.KVStorePrefixIterator(store, prefix)
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		accounts = append(accounts, sdk.AccAddress(it.Key()[len(prefix):]))
+	}
+	return
+}
+
+// GetAllFrozenAccounts returns the frozen accounts of all the tokens
+func (k Keeper) GetAllFrozenAccounts(ctx sdk.Context) (accounts []types.FrozenAccount) {
+	for _, token := range k.GetTokens(ctx, nil) {
+		for _, addr := range k.GetFrozenAccounts(ctx, token.GetSymbol()) {
+			accounts = append(accounts, types.FrozenAccount{
+				Symbol:  token.GetSymbol(),
+				Address: addr,
+			})
+		}
+	}
+	return
+}
+
+// SetFrozenAccount freezes the balance of the account for the specified symbol
+func (k Keeper) SetFrozenAccount(ctx sdk.Context, symbol string, addr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshalBinaryBare(&gogotypes.BoolValue{Value: true})
+
+	store.Set(types.KeyFrozenAccount(symbol, addr), bz)
+}
+
+func (k Keeper) deleteFrozenAccount(ctx sdk.Context, symbol string, addr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyFrozenAccount(symbol, addr))
+}
+
+// ValidateTransfer returns an error if any token among the coins sent from or to the account is paused,
+// or the account is frozen for it
+func (k Keeper) ValidateTransfer(ctx sdk.Context, addr sdk.AccAddress, coins sdk.Coins) error {
+	for _, coin := range coins {
+		token, err := k.GetToken(ctx, coin.Denom)
+		if err != nil || token.GetMinUnit() != coin.Denom {
+			continue
+		}
+
+		if token.GetPaused() {
+			return sdkerrors.Wrapf(types.ErrTokenPaused, "the token %s is paused", token.GetSymbol())
+		}
+
+		if k.IsFrozenAccount(ctx, token.GetSymbol(), addr) {
+			return sdkerrors.Wrapf(types.ErrAccountFrozen, "the account %s is frozen for the token %s", addr, token.GetSymbol())
+		}
+	}
+	return nil
+}
@@ -45,7 +45,28 @@ func (k Keeper) Tokens(c context.Context, req *types.QueryTokensRequest) (*types
 	}
 
 	ctx := sdk.UnwrapSDKContext(c)
-	tokens := k.GetTokens(ctx, req.Owner)
+
+	var owner sdk.AccAddress
+	if len(req.Owner) > 0 {
+		var err error
+		if owner, err = sdk.AccAddressFromBech32(req.Owner); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid owner address %s: %s", req.Owner, err)
+		}
+	}
+
+	if _, err := types.ParseBool(req.Mintable.String()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid mintable filter %s: %s", req.Mintable, err)
+	}
+
+	tokens, pageRes, err := k.GetPaginatedTokens(ctx, types.QueryTokensParams{
+		Owner:        owner,
+		Mintable:     req.Mintable,
+		SymbolPrefix: req.SymbolPrefix,
+		Pagination:   req.Pagination,
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 
 	result := make([]*codectypes.Any, len(tokens))
 	for i, token := range tokens {
@@ -61,7 +82,86 @@ func (k Keeper) Tokens(c context.Context, req *types.QueryTokensRequest) (*types
 		}
 	}
 
-	return &types.QueryTokensResponse{Tokens: result}, nil
+	return &types.QueryTokensResponse{Tokens: result, Pagination: pageRes}, nil
+}
+
+func (k Keeper) Supply(c context.Context, req *types.QuerySupplyRequest) (*types.QuerySupplyResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	supply, mainSupply, err := k.GetSupply(ctx, strings.ToLower(req.Denom))
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "token %s not found", req.Denom)
+	}
+
+	return &types.QuerySupplyResponse{Supply: supply, MainSupply: mainSupply}, nil
+}
+
+func (k Keeper) Holders(c context.Context, req *types.QueryHoldersRequest) (*types.QueryHoldersResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	denom := strings.ToLower(req.Denom)
+	if !k.HasToken(ctx, denom) {
+		return nil, status.Errorf(codes.NotFound, "token %s not found", req.Denom)
+	}
+
+	holders, pageRes, err := k.GetPaginatedHolders(ctx, denom, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryHoldersResponse{Holders: holders, Pagination: pageRes}, nil
+}
+
+func (k Keeper) HolderCount(c context.Context, req *types.QueryHolderCountRequest) (*types.QueryHolderCountResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	count, err := k.GetHolderCount(ctx, strings.ToLower(req.Denom))
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "token %s not found", req.Denom)
+	}
+
+	return &types.QueryHolderCountResponse{Count: count}, nil
+}
+
+func (k Keeper) TokenHistory(c context.Context, req *types.QueryTokenHistoryRequest) (*types.QueryTokenHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// the history of a retired token is kept under its retirement
+	if req.RetireHeight > 0 {
+		entries, pageRes, err := k.GetRetiredTokenHistory(ctx, strings.ToLower(req.Symbol), req.RetireHeight, req.Pagination)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return &types.QueryTokenHistoryResponse{Entries: entries, Pagination: pageRes}, nil
+	}
+
+	token, err := k.GetToken(ctx, strings.ToLower(req.Symbol))
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "token %s not found", req.Symbol)
+	}
+
+	entries, pageRes, err := k.GetTokenHistory(ctx, token.GetSymbol(), req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryTokenHistoryResponse{Entries: entries, Pagination: pageRes}, nil
 }
 
 func (k Keeper) Fees(c context.Context, req *types.QueryFeesRequest) (*types.QueryFeesResponse, error) {
@@ -76,8 +176,14 @@ func (k Keeper) Fees(c context.Context, req *types.QueryFeesRequest) (*types.Que
 	}
 
 	symbol := strings.ToLower(req.Symbol)
-	issueFee := k.GetTokenIssueFee(ctx, symbol)
-	mintFee := k.GetTokenMintFee(ctx, symbol)
+	issueFee, err := k.ConvertFee(ctx, k.GetTokenIssueFee(ctx, symbol), req.FeeDenom)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	mintFee, err := k.ConvertFee(ctx, k.GetTokenMintFee(ctx, symbol), req.FeeDenom)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
 
 	resp := &types.QueryFeesResponse{
 		Exist:    k.HasToken(ctx, symbol),
@@ -87,6 +193,188 @@ func (k Keeper) Fees(c context.Context, req *types.QueryFeesRequest) (*types.Que
 	return resp, nil
 }
 
+func (k Keeper) FrozenAccounts(c context.Context, req *types.QueryFrozenAccountsRequest) (*types.QueryFrozenAccountsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	token, err := k.GetToken(ctx, strings.ToLower(req.Symbol))
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "token %s not found", req.Symbol)
+	}
+
+	return &types.QueryFrozenAccountsResponse{
+		Addresses: k.GetFrozenAccounts(ctx, token.GetSymbol()),
+	}, nil
+}
+
+func (k Keeper) Minters(c context.Context, req *types.QueryMintersRequest) (*types.QueryMintersResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	token, err := k.GetToken(ctx, strings.ToLower(req.Symbol))
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "token %s not found", req.Symbol)
+	}
+
+	return &types.QueryMintersResponse{
+		Minters: k.GetMinters(ctx, token.GetSymbol()),
+	}, nil
+}
+
+func (k Keeper) Roles(c context.Context, req *types.QueryRolesRequest) (*types.QueryRolesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	token, err := k.GetToken(ctx, strings.ToLower(req.Symbol))
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "token %s not found", req.Symbol)
+	}
+
+	return &types.QueryRolesResponse{
+		Roles: k.GetRoles(ctx, token.GetSymbol()),
+	}, nil
+}
+
+func (k Keeper) VestingBalances(c context.Context, req *types.QueryVestingBalancesRequest) (*types.QueryVestingBalancesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Recipient.Empty() {
+		return nil, status.Errorf(codes.InvalidArgument, "recipient must be specified")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryVestingBalancesResponse{
+		Balances: k.GetVestingBalances(ctx, req.Recipient, strings.ToLower(req.Symbol)),
+	}, nil
+}
+
+func (k Keeper) PendingTransfers(c context.Context, req *types.QueryPendingTransfersRequest) (*types.QueryPendingTransfersResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var transfers []types.PendingTransfer
+	switch {
+	case len(req.Symbol) > 0:
+		token, err := k.GetToken(ctx, strings.ToLower(req.Symbol))
+		if err != nil {
+			return nil, status.Errorf(codes.NotFound, "token %s not found", req.Symbol)
+		}
+
+		transfer, err := k.GetPendingTransfer(ctx, token.GetSymbol())
+		if err == nil && (req.Recipient.Empty() || req.Recipient.Equals(transfer.DstOwner)) {
+			transfers = append(transfers, transfer)
+		}
+	case !req.Recipient.Empty():
+		transfers = k.GetPendingTransfersByRecipient(ctx, req.Recipient)
+	default:
+		transfers = k.GetAllPendingTransfers(ctx)
+	}
+
+	return &types.QueryPendingTransfersResponse{PendingTransfers: transfers}, nil
+}
+
+func (k Keeper) SnapshotBalance(c context.Context, req *types.QuerySnapshotBalanceRequest) (*types.QuerySnapshotBalanceResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Address.Empty() {
+		return nil, status.Errorf(codes.InvalidArgument, "address must be specified")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	token, err := k.GetToken(ctx, strings.ToLower(req.Symbol))
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "token %s not found", req.Symbol)
+	}
+
+	balance, err := k.GetBalanceAt(ctx, token.GetSymbol(), req.SnapshotId, req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "snapshot %d of the token %s not found", req.SnapshotId, req.Symbol)
+	}
+
+	return &types.QuerySnapshotBalanceResponse{Balance: balance}, nil
+}
+
+func (k Keeper) Dividends(c context.Context, req *types.QueryDividendsRequest) (*types.QueryDividendsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Holder.Empty() {
+		return nil, status.Errorf(codes.InvalidArgument, "holder must be specified")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	dividends, err := k.GetDividends(ctx, strings.ToLower(req.Symbol), req.Holder)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "token %s not found", req.Symbol)
+	}
+
+	return &types.QueryDividendsResponse{Dividends: dividends}, nil
+}
+
+func (k Keeper) Auction(c context.Context, req *types.QueryAuctionRequest) (*types.QueryAuctionResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	auction, err := k.GetAuction(ctx, strings.ToLower(req.Symbol))
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "auction of the symbol %s not found", req.Symbol)
+	}
+
+	return &types.QueryAuctionResponse{
+		Auction: auction,
+		Bids:    k.GetBids(ctx, auction.Symbol),
+	}, nil
+}
+
+func (k Keeper) SymbolReservation(c context.Context, req *types.QuerySymbolReservationRequest) (*types.QuerySymbolReservationResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	reservation, err := k.GetSymbolReservation(ctx, strings.ToLower(req.Symbol))
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "reservation of the symbol %s not found", req.Symbol)
+	}
+
+	return &types.QuerySymbolReservationResponse{Reservation: reservation}, nil
+}
+
+func (k Keeper) ExpiringTokens(c context.Context, req *types.QueryExpiringTokensRequest) (*types.QueryExpiringTokensResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	tokens := k.GetExpiringTokens(ctx, ctx.BlockHeight()+int64(req.Within))
+
+	return &types.QueryExpiringTokensResponse{Tokens: tokens}, nil
+}
+
 func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 	params := k.GetParamSet(ctx)
@@ -2,9 +2,12 @@ package keeper_test
 
 import (
 	gocontext "context"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/irismod/token/types"
 )
@@ -12,7 +15,7 @@ import (
 func (suite *KeeperTestSuite) TestGRPCQueryToken() {
 	app, ctx := suite.app, suite.ctx
 	_, _, addr := testdata.KeyTestPubAddr()
-	token := types.NewToken("btc", "Bitcoin Token", "satoshi", 18, 21000000, 22000000, true, addr)
+	token := types.NewToken("btc", "Bitcoin Token", "satoshi", 18, sdk.NewInt(21000000), sdk.NewInt(22000000), true, addr)
 
 	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
 	types.RegisterQueryServer(queryHelper, app.TokenKeeper)
@@ -34,6 +37,123 @@ func (suite *KeeperTestSuite) TestGRPCQueryToken() {
 	suite.Require().NoError(err)
 	suite.Require().NotNil(tokensResp1)
 	suite.Len(tokensResp1.Tokens, 2)
+
+	suite.app.TokenKeeper.AddToken(ctx, types.NewToken("bch", "Bitcoin Cash", "bchsatoshi", 8, sdk.NewInt(21000000), sdk.NewInt(21000000), false, addr))
+	suite.app.TokenKeeper.AddToken(ctx, types.NewToken("eth", "Ethereum", "wei", 18, sdk.NewInt(100000000), sdk.NewInt(100000000), true, owner))
+
+	// Query tokens with filters
+	tokensResp2, err := queryClient.Tokens(gocontext.Background(), &types.QueryTokensRequest{Owner: addr.String(), SymbolPrefix: "b"})
+	suite.Require().NoError(err)
+	suite.Len(tokensResp2.Tokens, 2)
+
+	tokensResp3, err := queryClient.Tokens(gocontext.Background(), &types.QueryTokensRequest{Mintable: types.False})
+	suite.Require().NoError(err)
+	suite.Len(tokensResp3.Tokens, 1)
+
+	tokensResp4, err := queryClient.Tokens(gocontext.Background(), &types.QueryTokensRequest{Owner: addr.String(), Mintable: types.True})
+	suite.Require().NoError(err)
+	suite.Len(tokensResp4.Tokens, 1)
+
+	_, err = queryClient.Tokens(gocontext.Background(), &types.QueryTokensRequest{Owner: "invalid"})
+	suite.Require().Error(err)
+
+	// Query tokens by pages
+	tokensResp5, err := queryClient.Tokens(gocontext.Background(), &types.QueryTokensRequest{
+		Pagination: &query.PageRequest{Limit: 3, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Len(tokensResp5.Tokens, 3)
+	suite.Equal(uint64(4), tokensResp5.Pagination.Total)
+	suite.NotNil(tokensResp5.Pagination.NextKey)
+
+	tokensResp6, err := queryClient.Tokens(gocontext.Background(), &types.QueryTokensRequest{
+		Pagination: &query.PageRequest{Key: tokensResp5.Pagination.NextKey, Limit: 3},
+	})
+	suite.Require().NoError(err)
+	suite.Len(tokensResp6.Tokens, 1)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQuerySupply() {
+	app, ctx := suite.app, suite.ctx
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.TokenKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	msg := types.NewMsgIssueToken("btc", "satoshi", "Bitcoin Network", 2, sdk.NewInt(1000), sdk.NewInt(2000), true, owner)
+	msg.Allocations = []types.VestingAllocation{{
+		Recipient: sdk.AccAddress([]byte("tokenInvestor")),
+		Amount:    sdk.NewInt(400),
+		EndTime:   ctx.BlockTime().Add(time.Hour),
+	}}
+	suite.Require().NoError(app.TokenKeeper.IssueToken(ctx, *msg))
+	suite.Require().NoError(app.TokenKeeper.BurnToken(ctx, *types.NewMsgBurnToken("btc", owner, sdk.NewInt(100))))
+
+	supplyResp, err := queryClient.Supply(gocontext.Background(), &types.QuerySupplyRequest{Denom: "satoshi"})
+	suite.Require().NoError(err)
+
+	suite.Equal("90000satoshi", supplyResp.Supply.TotalSupply.String())
+	suite.Equal("200000satoshi", supplyResp.Supply.MaxSupply.String())
+	suite.Equal("100000satoshi", supplyResp.Supply.Mintable.String())
+	suite.Equal("10000satoshi", supplyResp.Supply.Burned.String())
+	suite.Equal("40000satoshi", supplyResp.Supply.ModuleHeld.String())
+
+	suite.Equal(sdk.NewDecCoin("btc", sdk.NewInt(900)), supplyResp.MainSupply.TotalSupply)
+	suite.Equal(sdk.NewDecCoin("btc", sdk.NewInt(2000)), supplyResp.MainSupply.MaxSupply)
+	suite.Equal(sdk.NewDecCoin("btc", sdk.NewInt(1000)), supplyResp.MainSupply.Mintable)
+	suite.Equal(sdk.NewDecCoin("btc", sdk.NewInt(100)), supplyResp.MainSupply.Burned)
+	suite.Equal(sdk.NewDecCoin("btc", sdk.NewInt(400)), supplyResp.MainSupply.ModuleHeld)
+
+	_, err = queryClient.Supply(gocontext.Background(), &types.QuerySupplyRequest{Denom: "eth"})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryHolders() {
+	app, ctx := suite.app, suite.ctx
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.TokenKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	holder := sdk.AccAddress([]byte("tokenHolder"))
+	msg := types.NewMsgIssueToken("btc", "satoshi", "Bitcoin Network", 0, sdk.NewInt(1000), sdk.NewInt(2000), true, owner)
+	suite.Require().NoError(app.TokenKeeper.IssueToken(ctx, *msg))
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, owner, holder, sdk.NewCoins(sdk.NewCoin("satoshi", sdk.NewInt(600)))))
+
+	holdersResp, err := queryClient.Holders(gocontext.Background(), &types.QueryHoldersRequest{
+		Denom:      "btc",
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Equal([]types.Holder{{Address: holder, Balance: sdk.NewCoin("satoshi", sdk.NewInt(600))}}, holdersResp.Holders)
+	suite.Equal(uint64(2), holdersResp.Pagination.Total)
+
+	countResp, err := queryClient.HolderCount(gocontext.Background(), &types.QueryHolderCountRequest{Denom: "satoshi"})
+	suite.Require().NoError(err)
+	suite.Equal(uint64(2), countResp.Count)
+
+	_, err = queryClient.Holders(gocontext.Background(), &types.QueryHoldersRequest{Denom: "eth"})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryTokenHistory() {
+	app, ctx := suite.app, suite.ctx
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.TokenKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	msg := types.NewMsgIssueToken("btc", "satoshi", "Bitcoin Network", 0, sdk.NewInt(1000), sdk.NewInt(2000), true, owner)
+	suite.Require().NoError(app.TokenKeeper.IssueToken(ctx, *msg))
+	suite.Require().NoError(app.TokenKeeper.EditToken(ctx, *types.NewMsgEditToken("Bitcoin Token", "btc", sdk.NewInt(0), types.Nil, owner)))
+
+	historyResp, err := queryClient.TokenHistory(gocontext.Background(), &types.QueryTokenHistoryRequest{Symbol: "BTC"})
+	suite.Require().NoError(err)
+	suite.Len(historyResp.Entries, 1)
+	suite.Equal([]types.TokenChange{{Field: "name", OldValue: "Bitcoin Network", NewValue: "Bitcoin Token"}}, historyResp.Entries[0].Changes)
+
+	_, err = queryClient.TokenHistory(gocontext.Background(), &types.QueryTokenHistoryRequest{Symbol: "eth"})
+	suite.Require().Error(err)
 }
 
 func (suite *KeeperTestSuite) TestGRPCQueryFees() {
@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/irismod/token/types"
+)
+
+// GetTokenHistory returns a page of the recorded mutations of the specified symbol, ordered from the earliest
+func (k Keeper) GetTokenHistory(ctx sdk.Context, symbol string, pagination *query.PageRequest) ([]types.TokenHistoryEntry, *query.PageResponse, error) {
+	historyStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyTokenHistory(symbol))
+	return k.paginateTokenHistory(historyStore, pagination)
+}
+
+// GetRetiredTokenHistory returns a page of the recorded mutations of the specified symbol retired at the height, ordered from the earliest
+func (k Keeper) GetRetiredTokenHistory(ctx sdk.Context, symbol string, retireHeight int64, pagination *query.PageRequest) ([]types.TokenHistoryEntry, *query.PageResponse, error) {
+	historyStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyRetiredTokenHistory(symbol, retireHeight))
+	return k.paginateTokenHistory(historyStore, pagination)
+}
+
+func (k Keeper) paginateTokenHistory(historyStore prefix.Store, pagination *query.PageRequest) ([]types.TokenHistoryEntry, *query.PageResponse, error) {
+	var entries []types.TokenHistoryEntry
+	pageRes, err := query.Paginate(historyStore, pagination, func(_ []byte, value []byte) error {
+		var entry types.TokenHistoryEntry
+		if err := k.cdc.UnmarshalBinaryBare(value, &entry); err != nil {
+			return err
+		}
+
+		entries = append(entries, entry)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return entries, pageRes, nil
+}
+
+// GetAllTokenHistory returns the recorded mutations of all the tokens, including the retired ones
+func (k Keeper) GetAllTokenHistory(ctx sdk.Context) (entries []types.TokenHistoryEntry) {
+	store := ctx.KVStore(k.storeKey)
+
+	for _, prefix := range [][]byte{types.PrefixTokenHistory, types.PrefixRetiredTokenHistory} {
+		it := sdk.KVStorePrefixIterator(store, prefix)
+
+		for ; it.Valid(); it.Next() {
+			var entry types.TokenHistoryEntry
+			k.cdc.MustUnmarshalBinaryBare(it.Value(), &entry)
+
+			entries = append(entries, entry)
+		}
+		it.Close()
+	}
+	return
+}
+
+// GetLatestTokenHistorySequence returns the sequence of the latest recorded mutation of the specified symbol, or 0 if none is recorded
+func (k Keeper) GetLatestTokenHistorySequence(ctx sdk.Context, symbol string) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	it := sdk.KVStoreReversePrefixIterator(store, types.KeyTokenHistory(symbol))
+	defer it.Close()
+
+	if !it.Valid() {
+		return 0
+	}
+
+	var entry types.TokenHistoryEntry
+	k.cdc.MustUnmarshalBinaryBare(it.Value(), &entry)
+	return entry.Sequence
+}
+
+// SetTokenHistoryEntry saves the recorded mutation of the token, under the retirement if the token is retired
+func (k Keeper) SetTokenHistoryEntry(ctx sdk.Context, entry types.TokenHistoryEntry) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&entry)
+
+	if entry.RetireHeight > 0 {
+		store.Set(types.KeyRetiredTokenHistoryEntry(entry.Symbol, entry.RetireHeight, entry.Sequence), bz)
+		return
+	}
+	store.Set(types.KeyTokenHistoryEntry(entry.Symbol, entry.Sequence), bz)
+}
+
+// updateToken saves the mutated token and appends the changed fields to its history.
+// The signer is empty for the mutations made by the chain
+func (k Keeper) updateToken(ctx sdk.Context, token types.Token, signer sdk.AccAddress, action string) error {
+	old, err := k.getToken(ctx, token.Symbol)
+	if err != nil {
+		return err
+	}
+
+	if err := k.setToken(ctx, token); err != nil {
+		return err
+	}
+
+	changes := types.TokenChanges(old, token)
+	if len(changes) == 0 {
+		return nil
+	}
+
+	k.SetTokenHistoryEntry(ctx, types.TokenHistoryEntry{
+		Symbol:   token.Symbol,
+		Sequence: k.GetLatestTokenHistorySequence(ctx, token.Symbol) + 1,
+		Height:   ctx.BlockHeight(),
+		Time:     ctx.BlockTime(),
+		Signer:   signer,
+		Action:   action,
+		Changes:  changes,
+	})
+	return nil
+}
+
+// retireTokenHistory moves the recorded mutations of the specified symbol under its retirement at the height,
+// so that the history is kept while the symbol registered again starts a new one
+func (k Keeper) retireTokenHistory(ctx sdk.Context, symbol string, retireHeight int64) {
+	store := ctx.KVStore(k.storeKey)
+
+	var entries []types.TokenHistoryEntry
+	it := sdk.KVStorePrefixIterator(store, types.KeyTokenHistory(symbol))
+	for ; it.Valid(); it.Next() {
+		var entry types.TokenHistoryEntry
+		k.cdc.MustUnmarshalBinaryBare(it.Value(), &entry)
+
+		entries = append(entries, entry)
+	}
+	it.Close()
+
+	for _, entry := range entries {
+		store.Delete(types.KeyTokenHistoryEntry(entry.Symbol, entry.Sequence))
+
+		entry.RetireHeight = retireHeight
+		k.SetTokenHistoryEntry(ctx, entry)
+	}
+}
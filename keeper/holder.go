@@ -0,0 +1,171 @@
+package keeper
+
+import (
+	gogotypes "github.com/gogo/protobuf/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/irismod/token/types"
+)
+
+// GetHolder returns the indexed balance of the holder of the token with the specified min_unit
+func (k Keeper) GetHolder(ctx sdk.Context, minUnit string, addr sdk.AccAddress) (holder types.Holder, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.KeyHolder(minUnit, addr))
+	if bz == nil {
+		return holder, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &holder)
+	return holder, true
+}
+
+// GetPaginatedHolders returns a page of the holders of the token with the specified symbol or min_unit, ordered by balance from the largest
+func (k Keeper) GetPaginatedHolders(ctx sdk.Context, denom string, pagination *query.PageRequest) ([]types.Holder, *query.PageResponse, error) {
+	token, err := k.GetToken(ctx, denom)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !isHolderIndexed(ctx.KVStore(k.storeKey), token.GetMinUnit()) {
+		return nil, nil, sdkerrors.Wrapf(types.ErrHoldersNotIndexed, "the holders of the native token %s are not indexed", token.GetSymbol())
+	}
+
+	holderStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyHoldersByBalance(token.GetMinUnit()))
+
+	var holders []types.Holder
+	pageRes, err := query.Paginate(holderStore, pagination, func(_ []byte, value []byte) error {
+		var holder types.Holder
+		if err := k.cdc.UnmarshalBinaryBare(value, &holder); err != nil {
+			return err
+		}
+
+		holders = append(holders, holder)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return holders, pageRes, nil
+}
+
+// GetHolderCount returns the number of the holders of the token with the specified symbol or min_unit
+func (k Keeper) GetHolderCount(ctx sdk.Context, denom string) (uint64, error) {
+	token, err := k.GetToken(ctx, denom)
+	if err != nil {
+		return 0, err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	if !isHolderIndexed(store, token.GetMinUnit()) {
+		return 0, sdkerrors.Wrapf(types.ErrHoldersNotIndexed, "the holders of the native token %s are not indexed", token.GetSymbol())
+	}
+
+	return getHolderCount(store, k.cdc, token.GetMinUnit()), nil
+}
+
+// RebuildHolderIndexes rebuilds the holder indexes of all the indexed tokens from the bank balances
+func (k Keeper) RebuildHolderIndexes(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+
+	deleteByPrefixes(store, types.PrefixHolders, types.PrefixHoldersByBalance, types.PrefixHolderCounts)
+
+	minUnits := make(map[string]bool)
+	for _, token := range k.getAllTokens(ctx) {
+		minUnits[token.MinUnit] = isHolderIndexed(store, token.MinUnit)
+	}
+
+	k.bankKeeper.IterateAllBalances(ctx, func(addr sdk.AccAddress, balance sdk.Coin) bool {
+		if minUnits[balance.Denom] {
+			setHolder(store, k.cdc, addr, balance)
+		}
+		return false
+	})
+}
+
+// isHolderIndexed returns true if the holders of the denom are indexed, which are those of the registered tokens
+// except the native token. The native token is held by nearly every account and pays the fees of every tx,
+// so indexing its holders would add writes to every tx
+func isHolderIndexed(store sdk.KVStore, denom string) bool {
+	return denom != types.GetNativeToken().MinUnit && store.Has(types.KeyMinUint(denom))
+}
+
+// deleteHolders removes the holder indexes of the token with the specified min_unit
+func (k Keeper) deleteHolders(ctx sdk.Context, minUnit string) {
+	store := ctx.KVStore(k.storeKey)
+
+	deleteByPrefixes(store, types.KeyHolders(minUnit), types.KeyHoldersByBalance(minUnit))
+	store.Delete(types.KeyHolderCount(minUnit))
+}
+
+// deleteByPrefixes removes all the entries with the specified prefixes
+func deleteByPrefixes(store sdk.KVStore, prefixes ...[]byte) {
+	var keys [][]byte
+	for _, keyPrefix := range prefixes {
+		it := sdk.KVStorePrefixIterator(store, keyPrefix)
+		for ; it.Valid(); it.Next() {
+			keys = append(keys, it.Key())
+		}
+		it.Close()
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// setHolder updates the holder indexes with the new balance of the holder. The holder is removed once the balance is zero
+func setHolder(store sdk.KVStore, cdc codec.BinaryMarshaler, addr sdk.AccAddress, balance sdk.Coin) {
+	key := types.KeyHolder(balance.Denom, addr)
+	count := getHolderCount(store, cdc, balance.Denom)
+
+	if bz := store.Get(key); bz != nil {
+		var holder types.Holder
+		cdc.MustUnmarshalBinaryBare(bz, &holder)
+
+		if holder.Balance.IsEqual(balance) {
+			return
+		}
+
+		store.Delete(key)
+		store.Delete(types.KeyHolderByBalance(balance.Denom, holder.Balance.Amount, addr))
+		count--
+	}
+
+	if balance.IsPositive() {
+		bz := cdc.MustMarshalBinaryBare(&types.Holder{Address: addr, Balance: balance})
+		store.Set(key, bz)
+		store.Set(types.KeyHolderByBalance(balance.Denom, balance.Amount, addr), bz)
+		count++
+	}
+
+	setHolderCount(store, cdc, balance.Denom, count)
+}
+
+// getHolderCount returns the number of the holders of the token with the specified min_unit
+func getHolderCount(store sdk.KVStore, cdc codec.BinaryMarshaler, minUnit string) uint64 {
+	bz := store.Get(types.KeyHolderCount(minUnit))
+	if bz == nil {
+		return 0
+	}
+
+	var count gogotypes.UInt64Value
+	cdc.MustUnmarshalBinaryBare(bz, &count)
+	return count.Value
+}
+
+// setHolderCount saves the number of the holders of the token with the specified min_unit
+func setHolderCount(store sdk.KVStore, cdc codec.BinaryMarshaler, minUnit string, count uint64) {
+	if count == 0 {
+		store.Delete(types.KeyHolderCount(minUnit))
+		return
+	}
+
+	bz := cdc.MustMarshalBinaryBare(&gogotypes.UInt64Value{Value: count})
+	store.Set(types.KeyHolderCount(minUnit), bz)
+}
@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irismod/token/types"
+)
+
+// SetHooks sets the token hooks, which can be set only once
+func (k *Keeper) SetHooks(th types.TokenHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set token hooks twice")
+	}
+
+	k.hooks = th
+	return k
+}
+
+// afterTokenIssued calls the hook after the token is issued
+func (k Keeper) afterTokenIssued(ctx sdk.Context, token types.Token) error {
+	if k.hooks == nil {
+		return nil
+	}
+	return k.hooks.AfterTokenIssued(ctx, token)
+}
+
+// afterTokenMinted calls the hook after the token is minted to the recipient
+func (k Keeper) afterTokenMinted(ctx sdk.Context, symbol string, recipient sdk.AccAddress, amount sdk.Coin) error {
+	if k.hooks == nil {
+		return nil
+	}
+	return k.hooks.AfterTokenMinted(ctx, symbol, recipient, amount)
+}
+
+// afterTokenEdited calls the hook after the token is edited
+func (k Keeper) afterTokenEdited(ctx sdk.Context, token types.Token, changes []types.TokenChange) error {
+	if k.hooks == nil {
+		return nil
+	}
+	return k.hooks.AfterTokenEdited(ctx, token, changes)
+}
+
+// afterTokenOwnerChanged calls the hook after the owner of the token is changed
+func (k Keeper) afterTokenOwnerChanged(ctx sdk.Context, symbol string, srcOwner, dstOwner sdk.AccAddress) error {
+	if k.hooks == nil {
+		return nil
+	}
+	return k.hooks.AfterTokenOwnerChanged(ctx, symbol, srcOwner, dstOwner)
+}
@@ -0,0 +1,267 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	gogotypes "github.com/gogo/protobuf/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irismod/token/types"
+)
+
+// RegisterInvariants registers all token invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "token-indexes", TokenIndexesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "orphaned-indexes", OrphanedIndexesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "max-supply", MaxSupplyInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "module-account", ModuleAccountInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "holder-index", HolderIndexInvariant(k))
+}
+
+// AllInvariants runs all invariants of the token module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := TokenIndexesInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		res, stop = OrphanedIndexesInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		res, stop = MaxSupplyInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		res, stop = ModuleAccountInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		return HolderIndexInvariant(k)(ctx)
+	}
+}
+
+// TokenIndexesInvariant checks that every token has exactly one min_unit index entry and one owner index entry
+func TokenIndexesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		minUnitIndexes := k.getIndexedSymbols(ctx, types.PrefixTokenForMinUint)
+		ownerIndexes := k.getIndexedSymbols(ctx, types.PrefixTokens)
+
+		for _, token := range k.getAllTokens(ctx) {
+			keys := minUnitIndexes[token.Symbol]
+			if len(keys) != 1 || !bytes.Equal(keys[0], types.KeyMinUint(token.MinUnit)) {
+				count++
+				msg += fmt.Sprintf("\tthe token %s has %d min_unit index entries\n", token.Symbol, len(keys))
+			}
+
+			keys = ownerIndexes[token.Symbol]
+			if len(keys) != 1 || !bytes.Equal(keys[0], types.KeyTokens(token.Owner, token.Symbol)) {
+				count++
+				msg += fmt.Sprintf("\tthe token %s has %d owner index entries\n", token.Symbol, len(keys))
+			}
+		}
+
+		broken := count != 0
+		return sdk.FormatInvariant(
+			types.ModuleName, "token-indexes",
+			fmt.Sprintf("amount of tokens with invalid index entries found %d\n%s", count, msg),
+		), broken
+	}
+}
+
+// OrphanedIndexesInvariant checks that every min_unit and owner index entry refers to an existing token
+func OrphanedIndexesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		store := ctx.KVStore(k.storeKey)
+		checkIndex := func(name string, prefix []byte, indexKey func(types.Token) []byte) {
+			it := sdk.KVStorePrefixIterator(store, prefix)
+			defer it.Close()
+
+			for ; it.Valid(); it.Next() {
+				var symbol gogotypes.StringValue
+				k.cdc.MustUnmarshalBinaryBare(it.Value(), &symbol)
+
+				token, err := k.getToken(ctx, symbol.Value)
+				if err != nil || !bytes.Equal(it.Key(), indexKey(token)) {
+					count++
+					msg += fmt.Sprintf("\tthe %s index entry %X refers to the token %s which does not match\n", name, it.Key(), symbol.Value)
+				}
+			}
+		}
+
+		checkIndex("min_unit", types.PrefixTokenForMinUint, func(token types.Token) []byte {
+			return types.KeyMinUint(token.MinUnit)
+		})
+		checkIndex("owner", types.PrefixTokens, func(token types.Token) []byte {
+			return types.KeyTokens(token.Owner, token.Symbol)
+		})
+
+		broken := count != 0
+		return sdk.FormatInvariant(
+			types.ModuleName, "orphaned-indexes",
+			fmt.Sprintf("amount of orphaned index entries found %d\n%s", count, msg),
+		), broken
+	}
+}
+
+// MaxSupplyInvariant checks that the total supply of every token does not exceed its max supply.
+// The native token is minted by the chain and excluded
+func MaxSupplyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		for _, token := range k.getAllTokens(ctx) {
+			if token.Symbol == types.GetNativeToken().Symbol {
+				continue
+			}
+
+			maxSupply := token.MaxSupply.Mul(sdk.NewIntWithDecimal(1, int(token.Scale)))
+			if supply := k.getTokenSupply(ctx, token.MinUnit); supply.GT(maxSupply) {
+				count++
+				msg += fmt.Sprintf("\tthe total supply %s%s of the token %s exceeds the max supply %s%s\n", supply, token.MinUnit, token.Symbol, maxSupply, token.MinUnit)
+			}
+		}
+
+		broken := count != 0
+		return sdk.FormatInvariant(
+			types.ModuleName, "max-supply",
+			fmt.Sprintf("amount of tokens exceeding the max supply found %d\n%s", count, msg),
+		), broken
+	}
+}
+
+// ModuleAccountInvariant checks that the token module account holds exactly the unvested coins,
+// the bid deposits and the dividend reserves, so that no fees are left over
+func ModuleAccountInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		expected := sdk.NewCoins()
+		for _, schedule := range k.GetAllVestingSchedules(ctx) {
+			expected = expected.Add(schedule.Total.Sub(schedule.Released))
+		}
+		for _, bid := range k.GetAllBids(ctx) {
+			expected = expected.Add(bid.Deposit)
+		}
+		for _, index := range k.GetAllDividendIndexes(ctx) {
+			expected = expected.Add(index.Reserve...)
+		}
+
+		balances := k.bankKeeper.GetAllBalances(ctx, k.accountKeeper.GetModuleAddress(types.ModuleName))
+
+		broken := !balances.IsEqual(expected)
+		return sdk.FormatInvariant(
+			types.ModuleName, "module-account",
+			fmt.Sprintf("\ttoken module account balances: %s\n\texpected balances: %s\n", balances, expected),
+		), broken
+	}
+}
+
+// HolderIndexInvariant checks that the holder index of every token matches the bank balances of the holders
+// and that the indexed balances add up to the total supply, so that no holder is missing.
+// The native token is not indexed and excluded
+func HolderIndexInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		store := ctx.KVStore(k.storeKey)
+		for _, token := range k.getAllTokens(ctx) {
+			if !isHolderIndexed(store, token.MinUnit) {
+				continue
+			}
+
+			var holders, orderedHolders uint64
+			indexed := sdk.ZeroInt()
+
+			it := sdk.KVStorePrefixIterator(store, types.KeyHolders(token.MinUnit))
+			for ; it.Valid(); it.Next() {
+				var holder types.Holder
+				k.cdc.MustUnmarshalBinaryBare(it.Value(), &holder)
+				holders++
+				indexed = indexed.Add(holder.Balance.Amount)
+
+				balance := k.bankKeeper.GetBalance(ctx, holder.Address, token.MinUnit)
+				if !holder.Balance.IsEqual(balance) || !store.Has(types.KeyHolderByBalance(token.MinUnit, balance.Amount, holder.Address)) {
+					count++
+					msg += fmt.Sprintf("\tthe holder %s of the token %s is indexed with %s while the balance is %s\n", holder.Address, token.Symbol, holder.Balance, balance)
+				}
+			}
+			it.Close()
+
+			it = sdk.KVStorePrefixIterator(store, types.KeyHoldersByBalance(token.MinUnit))
+			for ; it.Valid(); it.Next() {
+				orderedHolders++
+			}
+			it.Close()
+
+			if stored := getHolderCount(store, k.cdc, token.MinUnit); stored != holders || orderedHolders != holders {
+				count++
+				msg += fmt.Sprintf("\tthe token %s has %d indexed holders, %d ordered holders and the count %d\n", token.Symbol, holders, orderedHolders, stored)
+			}
+
+			if supply := k.getTokenSupply(ctx, token.MinUnit); !indexed.Equal(supply) {
+				count++
+				msg += fmt.Sprintf("\tthe indexed balances %s%s of the token %s do not add up to the total supply %s%s\n", indexed, token.MinUnit, token.Symbol, supply, token.MinUnit)
+			}
+		}
+
+		broken := count != 0
+		return sdk.FormatInvariant(
+			types.ModuleName, "holder-index",
+			fmt.Sprintf("amount of inconsistent holder index entries found %d\n%s", count, msg),
+		), broken
+	}
+}
+
+// getAllTokens returns all the tokens stored by symbol
+func (k Keeper) getAllTokens(ctx sdk.Context) (tokens []types.Token) {
+	store := ctx.KVStore(k.storeKey)
+
+	it := sdk.KVStorePrefixIterator(store, types.PrefixTokenForSymbol)
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		var token types.Token
+		k.cdc.MustUnmarshalBinaryBare(it.Value(), &token)
+
+		tokens = append(tokens, token)
+	}
+	return
+}
+
+// getIndexedSymbols returns the keys of the index entries with the specified prefix grouped by the referred symbols
+func (k Keeper) getIndexedSymbols(ctx sdk.Context, prefix []byte) map[string][][]byte {
+	store := ctx.KVStore(k.storeKey)
+
+	it := sdk.KVStorePrefixIterator(store, prefix)
+	defer it.Close()
+
+	keys := make(map[string][][]byte)
+	for ; it.Valid(); it.Next() {
+		var symbol gogotypes.StringValue
+		k.cdc.MustUnmarshalBinaryBare(it.Value(), &symbol)
+
+		keys[symbol.Value] = append(keys[symbol.Value], it.Key())
+	}
+	return keys
+}
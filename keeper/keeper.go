@@ -26,6 +26,8 @@ type Keeper struct {
 
 	// params subspace
 	paramSpace paramstypes.Subspace
+
+	hooks types.TokenHooks
 }
 
 func NewKeeper(cdc codec.Marshaler, key sdk.StoreKey, paramSpace paramstypes.Subspace,
@@ -44,6 +46,7 @@ func NewKeeper(cdc codec.Marshaler, key sdk.StoreKey, paramSpace paramstypes.Sub
 		storeKey:         key,
 		cdc:              cdc,
 		paramSpace:       paramSpace,
+		accountKeeper:    accountKeeper,
 		bankKeeper:       bankKeeper,
 		feeCollectorName: feeCollectorName,
 	}
@@ -56,12 +59,28 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("irismod/%s", types.ModuleName))
 }
 
+// beforeBalanceChange records the balances of the holder for the snapshots and settles the dividends
+// of the given tokens. It is called by the bank keeper wrapper before the balances of the holder change
+func (k Keeper) beforeBalanceChange(ctx sdk.Context, addr sdk.AccAddress, coins sdk.Coins) {
+	k.UpdateSnapshotBalances(ctx, addr, coins)
+	k.settleDividends(ctx, addr, coins)
+}
+
 // IssueToken issues a new token
 func (k Keeper) IssueToken(ctx sdk.Context, msg types.MsgIssueToken) error {
 	token := types.NewToken(
 		msg.Symbol, msg.Name, msg.MinUnit, msg.Scale, msg.InitialSupply,
 		msg.MaxSupply, msg.Mintable, msg.Owner,
 	)
+	token.SetMetadata(msg.Description, msg.Website, msg.LogoURI, msg.ContentHash)
+
+	if period := k.GetParamSet(ctx).SymbolLeasePeriod; period > 0 {
+		token.LeaseExpireHeight = ctx.BlockHeight() + int64(period)
+	}
+
+	if err := k.checkSymbolReservation(ctx, token.Symbol, token.Owner); err != nil {
+		return err
+	}
 
 	if err := k.AddToken(ctx, token); err != nil {
 		return err
@@ -69,7 +88,7 @@ func (k Keeper) IssueToken(ctx sdk.Context, msg types.MsgIssueToken) error {
 
 	initialSupply := sdk.NewCoin(
 		token.MinUnit,
-		sdk.NewIntWithDecimal(int64(msg.InitialSupply), int(msg.Scale)),
+		msg.InitialSupply.Mul(sdk.NewIntWithDecimal(1, int(msg.Scale))),
 	)
 
 	mintCoins := sdk.NewCoins(initialSupply)
@@ -79,13 +98,37 @@ func (k Keeper) IssueToken(ctx sdk.Context, msg types.MsgIssueToken) error {
 		return err
 	}
 
-	// sent coins to owner's account
-	if err := k.bankKeeper.SendCoinsFromModuleToAccount(
-		ctx, types.ModuleName, token.Owner, mintCoins,
-	); err != nil {
-		return err
+	// the vesting allocations are held by the module account until released
+	ownerCoin := initialSupply
+	for _, allocation := range msg.Allocations {
+		if !allocation.EndTime.After(ctx.BlockTime()) {
+			return sdkerrors.Wrapf(types.ErrInvalidAllocation, "the end time %s must be later than the current block time", allocation.EndTime)
+		}
+
+		total := sdk.NewCoin(token.MinUnit, allocation.Amount.Mul(sdk.NewIntWithDecimal(1, int(msg.Scale))))
+		ownerCoin = ownerCoin.Sub(total)
+
+		k.SetVestingSchedule(ctx, types.VestingSchedule{
+			Symbol:    token.Symbol,
+			Recipient: allocation.Recipient,
+			Total:     total,
+			Released:  sdk.NewCoin(token.MinUnit, sdk.ZeroInt()),
+			StartTime: ctx.BlockTime(),
+			CliffTime: allocation.CliffTime,
+			EndTime:   allocation.EndTime,
+		})
 	}
-	return nil
+
+	if ownerCoin.IsPositive() {
+		// sent coins to owner's account
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(
+			ctx, types.ModuleName, token.Owner, sdk.NewCoins(ownerCoin),
+		); err != nil {
+			return err
+		}
+	}
+
+	return k.afterTokenIssued(ctx, token)
 }
 
 // EditToken edits the specified token
@@ -97,16 +140,29 @@ func (k Keeper) EditToken(ctx sdk.Context, msg types.MsgEditToken) error {
 	}
 
 	token := tokenI.(*types.Token)
+	oldToken := *token
 
-	if !msg.Owner.Equals(token.Owner) {
-		return sdkerrors.Wrapf(types.ErrInvalidOwner, "the address %d is not the owner of the token %s", msg.Owner, msg.Symbol)
+	// the supply settings require the admin role, and the metadata requires the metadata-editor role
+	editsSupply := msg.MaxSupply.IsPositive() || msg.Mintable != types.Nil
+	if editsSupply {
+		if err := k.checkRole(ctx, *token, msg.Owner, types.RoleAdmin); err != nil {
+			return err
+		}
 	}
 
-	if msg.MaxSupply > 0 {
-		issuedAmt := k.getTokenSupply(ctx, token.MinUnit)
-		issuedMainUnitAmt := uint64(issuedAmt.Quo(sdk.NewIntWithDecimal(1, int(token.Scale))).Int64())
-		if msg.MaxSupply < issuedMainUnitAmt {
-			return sdkerrors.Wrapf(types.ErrInvalidMaxSupply, "max supply must not be less than %d", issuedMainUnitAmt)
+	editsMetadata := msg.Name != types.DoNotModify || msg.Description != types.DoNotModify || msg.Website != types.DoNotModify ||
+		msg.LogoURI != types.DoNotModify || msg.ContentHash != types.DoNotModify
+	if editsMetadata || !editsSupply {
+		if err := k.checkRole(ctx, *token, msg.Owner, types.RoleMetadataEditor); err != nil {
+			return err
+		}
+	}
+
+	if msg.MaxSupply.IsPositive() {
+		issuedAmt := k.getTokenSupply(ctx, token.MinUnit).Add(k.GetBurnCoin(ctx, token.MinUnit).Amount)
+		issuedMainUnitAmt := issuedAmt.Quo(sdk.NewIntWithDecimal(1, int(token.Scale)))
+		if msg.MaxSupply.LT(issuedMainUnitAmt) {
+			return sdkerrors.Wrapf(types.ErrInvalidMaxSupply, "max supply must not be less than %s", issuedMainUnitAmt)
 		}
 
 		token.MaxSupply = msg.MaxSupply
@@ -120,14 +176,35 @@ func (k Keeper) EditToken(ctx sdk.Context, msg types.MsgEditToken) error {
 		token.Mintable = msg.Mintable.ToBool()
 	}
 
-	if err := k.setToken(ctx, *token); err != nil {
+	if msg.Description != types.DoNotModify {
+		token.Description = strings.TrimSpace(msg.Description)
+	}
+
+	if msg.Website != types.DoNotModify {
+		token.Website = strings.TrimSpace(msg.Website)
+	}
+
+	if msg.LogoURI != types.DoNotModify {
+		token.LogoURI = strings.TrimSpace(msg.LogoURI)
+	}
+
+	if msg.ContentHash != types.DoNotModify {
+		token.ContentHash = strings.TrimSpace(msg.ContentHash)
+	}
+
+	if err := k.updateToken(ctx, *token, msg.Owner, msg.Type()); err != nil {
 		return err
 	}
 
-	return nil
+	changes := types.TokenChanges(oldToken, *token)
+	if len(changes) == 0 {
+		return nil
+	}
+	return k.afterTokenEdited(ctx, *token, changes)
 }
 
-// TransferTokenOwner transfers the owner of the specified token to a new one
+// TransferTokenOwner starts transferring the owner of the specified token to a new one.
+// The transfer takes effect only after the new owner accepts it
 func (k Keeper) TransferTokenOwner(ctx sdk.Context, msg types.MsgTransferTokenOwner) error {
 	tokenI, err := k.GetToken(ctx, msg.Symbol)
 	if err != nil {
@@ -140,22 +217,66 @@ func (k Keeper) TransferTokenOwner(ctx sdk.Context, msg types.MsgTransferTokenOw
 		return sdkerrors.Wrapf(types.ErrInvalidOwner, "the address %s is not the owner of the token %s", msg.SrcOwner.String(), msg.Symbol)
 	}
 
+	if err := checkLease(*token); err != nil {
+		return err
+	}
+
+	// a new transfer replaces the pending one
+	if transfer, err := k.GetPendingTransfer(ctx, token.Symbol); err == nil {
+		k.RemovePendingTransfer(ctx, transfer)
+	}
+
+	var expireHeight int64
+	if period := k.GetParamSet(ctx).PendingTransferPeriod; period > 0 {
+		expireHeight = ctx.BlockHeight() + int64(period)
+	}
+
+	k.SetPendingTransfer(ctx, types.PendingTransfer{
+		Symbol:       token.Symbol,
+		SrcOwner:     msg.SrcOwner,
+		DstOwner:     msg.DstOwner,
+		ExpireHeight: expireHeight,
+	})
+	return nil
+}
+
+// AcceptTokenOwner completes the pending transfer of the specified token to the new owner
+func (k Keeper) AcceptTokenOwner(ctx sdk.Context, msg types.MsgAcceptTokenOwner) error {
+	tokenI, err := k.GetToken(ctx, msg.Symbol)
+	if err != nil {
+		return err
+	}
+
+	token := tokenI.(*types.Token)
+
+	transfer, err := k.GetPendingTransfer(ctx, token.Symbol)
+	if err != nil {
+		return err
+	}
+
+	if !msg.DstOwner.Equals(transfer.DstOwner) {
+		return sdkerrors.Wrapf(types.ErrTransferNotExists, "the token %s is not pending transfer to the address %s", msg.Symbol, msg.DstOwner.String())
+	}
+
+	k.RemovePendingTransfer(ctx, transfer)
+
+	srcOwner := token.Owner
 	token.Owner = msg.DstOwner
 	// update token information
-	if err := k.setToken(ctx, *token); err != nil {
+	if err := k.updateToken(ctx, *token, msg.DstOwner, msg.Type()); err != nil {
 		return err
 	}
 
 	// reset all index for query-token
-	if err := k.resetStoreKeyForQueryToken(ctx, msg, *token); err != nil {
+	if err := k.resetStoreKeyForQueryToken(ctx, srcOwner, msg.DstOwner, *token); err != nil {
 		return err
 	}
 
-	return nil
+	return k.afterTokenOwnerChanged(ctx, token.Symbol, srcOwner, msg.DstOwner)
 }
 
-// MintToken mints specified amount token to a specified owner
-func (k Keeper) MintToken(ctx sdk.Context, msg types.MsgMintToken) error {
+// CancelTransferTokenOwner cancels the pending transfer of the specified token
+func (k Keeper) CancelTransferTokenOwner(ctx sdk.Context, msg types.MsgCancelTransferTokenOwner) error {
 	tokenI, err := k.GetToken(ctx, msg.Symbol)
 	if err != nil {
 		return err
@@ -167,19 +288,73 @@ func (k Keeper) MintToken(ctx sdk.Context, msg types.MsgMintToken) error {
 		return sdkerrors.Wrapf(types.ErrInvalidOwner, "the address %s is not the owner of the token %s", msg.Owner.String(), msg.Symbol)
 	}
 
+	if err := checkLease(*token); err != nil {
+		return err
+	}
+
+	transfer, err := k.GetPendingTransfer(ctx, token.Symbol)
+	if err != nil {
+		return err
+	}
+
+	k.RemovePendingTransfer(ctx, transfer)
+	return nil
+}
+
+// MintToken mints specified amount token to a specified owner
+func (k Keeper) MintToken(ctx sdk.Context, msg types.MsgMintToken) error {
+	return k.mintTokens(ctx, msg.Symbol, msg.Owner, []types.MintRecipient{{Address: msg.To, Amount: msg.Amount}})
+}
+
+// MultiMint mints the token to multiple recipients at once
+func (k Keeper) MultiMint(ctx sdk.Context, msg types.MsgMultiMint) error {
+	return k.mintTokens(ctx, msg.Symbol, msg.Owner, msg.Recipients)
+}
+
+// mintTokens mints the token to the given recipients, which is authorized and
+// checked against the max supply as a whole. An empty recipient address mints to the owner
+func (k Keeper) mintTokens(ctx sdk.Context, symbol string, signer sdk.AccAddress, recipients []types.MintRecipient) error {
+	tokenI, err := k.GetToken(ctx, symbol)
+	if err != nil {
+		return err
+	}
+
+	token := tokenI.(*types.Token)
+
+	amount := sdk.ZeroInt()
+	for _, recipient := range recipients {
+		amount = amount.Add(recipient.Amount)
+	}
+
+	// a minter other than the owner or the minter role must hold a sufficient quota
+	var minter *types.Minter
+	if err := k.checkRole(ctx, *token, signer, types.RoleMinter); err != nil {
+		m, err := k.getMintableQuota(ctx, *token, signer, amount)
+		if err != nil {
+			return err
+		}
+		minter = &m
+	}
+
 	if !token.Mintable {
-		return sdkerrors.Wrapf(types.ErrNotMintable, "the token %s is set to be non-mintable", msg.Symbol)
+		return sdkerrors.Wrapf(types.ErrNotMintable, "the token %s is set to be non-mintable", symbol)
+	}
+
+	if token.Paused {
+		return sdkerrors.Wrapf(types.ErrTokenPaused, "the token %s is paused", symbol)
 	}
 
-	issuedAmt := k.getTokenSupply(ctx, token.MinUnit)
-	mintableMaxAmt := sdk.NewIntWithDecimal(int64(token.MaxSupply), int(token.Scale)).Sub(issuedAmt)
-	mintableMaxMainUnitAmt := uint64(mintableMaxAmt.Quo(sdk.NewIntWithDecimal(1, int(token.Scale))).Int64())
+	// the burned tokens are still counted against the max supply
+	precision := sdk.NewIntWithDecimal(1, int(token.Scale))
+	issuedAmt := k.getTokenSupply(ctx, token.MinUnit).Add(k.GetBurnCoin(ctx, token.MinUnit).Amount)
+	mintableMaxAmt := token.MaxSupply.Mul(precision).Sub(issuedAmt)
+	mintableMaxMainUnitAmt := mintableMaxAmt.Quo(precision)
 
-	if msg.Amount > mintableMaxMainUnitAmt {
-		return sdkerrors.Wrapf(types.ErrInvalidMaxSupply, "The amount of minting tokens plus the total amount of issued tokens has exceeded the maximum supply, only accepts amount (0, %d]", mintableMaxMainUnitAmt)
+	if amount.GT(mintableMaxMainUnitAmt) {
+		return sdkerrors.Wrapf(types.ErrInvalidMaxSupply, "The amount of minting tokens plus the total amount of issued tokens has exceeded the maximum supply, only accepts amount (0, %s]", mintableMaxMainUnitAmt)
 	}
 
-	mintCoin := sdk.NewCoin(token.MinUnit, sdk.NewIntWithDecimal(int64(msg.Amount), int(token.Scale)))
+	mintCoin := sdk.NewCoin(token.MinUnit, amount.Mul(precision))
 	mintCoins := sdk.NewCoins(mintCoin)
 
 	// mint coins
@@ -187,15 +362,239 @@ func (k Keeper) MintToken(ctx sdk.Context, msg types.MsgMintToken) error {
 		return err
 	}
 
-	mintAcc := msg.To
-	if mintAcc.Empty() {
-		mintAcc = token.Owner
+	for _, recipient := range recipients {
+		mintAcc := recipient.Address
+		if mintAcc.Empty() {
+			mintAcc = token.Owner
+		}
+
+		recipientCoins := sdk.NewCoins(sdk.NewCoin(token.MinUnit, recipient.Amount.Mul(precision)))
+		if err := k.ValidateTransfer(ctx, mintAcc, recipientCoins); err != nil {
+			return err
+		}
+
+		// sent coins to the recipient's account
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mintAcc, recipientCoins); err != nil {
+			return err
+		}
+
+		if err := k.afterTokenMinted(ctx, token.Symbol, mintAcc, recipientCoins[0]); err != nil {
+			return err
+		}
+	}
+
+	if minter != nil {
+		k.consumeMinterQuota(ctx, *minter, amount)
+	}
+
+	return nil
+}
+
+// BurnToken burns specified amount token from the sender
+func (k Keeper) BurnToken(ctx sdk.Context, msg types.MsgBurnToken) error {
+	tokenI, err := k.GetToken(ctx, msg.Symbol)
+	if err != nil {
+		return err
+	}
+
+	token := tokenI.(*types.Token)
+
+	if token.Paused {
+		return sdkerrors.Wrapf(types.ErrTokenPaused, "the token %s is paused", msg.Symbol)
+	}
+
+	burnCoin := sdk.NewCoin(token.MinUnit, msg.Amount.Mul(sdk.NewIntWithDecimal(1, int(token.Scale))))
+	burnCoins := sdk.NewCoins(burnCoin)
+
+	// send coins from the sender's account to the module account
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, msg.Sender, types.ModuleName, burnCoins); err != nil {
+		return err
+	}
+
+	// burn coins
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, burnCoins); err != nil {
+		return err
+	}
+
+	k.AddBurnCoin(ctx, burnCoin)
+
+	return nil
+}
+
+// FreezeAccount freezes the balance of the specified account for the token
+func (k Keeper) FreezeAccount(ctx sdk.Context, msg types.MsgFreezeAccount) error {
+	tokenI, err := k.GetToken(ctx, msg.Symbol)
+	if err != nil {
+		return err
+	}
+
+	token := tokenI.(*types.Token)
+
+	if err := k.checkRole(ctx, *token, msg.Owner, types.RoleFreezer); err != nil {
+		return err
+	}
+
+	if k.IsFrozenAccount(ctx, token.Symbol, msg.Address) {
+		return sdkerrors.Wrapf(types.ErrAccountFrozen, "the account %s has been frozen for the token %s", msg.Address.String(), msg.Symbol)
+	}
+
+	k.SetFrozenAccount(ctx, token.Symbol, msg.Address)
+	return nil
+}
+
+// UnfreezeAccount unfreezes the balance of the specified account for the token
+func (k Keeper) UnfreezeAccount(ctx sdk.Context, msg types.MsgUnfreezeAccount) error {
+	tokenI, err := k.GetToken(ctx, msg.Symbol)
+	if err != nil {
+		return err
+	}
+
+	token := tokenI.(*types.Token)
+
+	if err := k.checkRole(ctx, *token, msg.Owner, types.RoleFreezer); err != nil {
+		return err
+	}
+
+	if !k.IsFrozenAccount(ctx, token.Symbol, msg.Address) {
+		return sdkerrors.Wrapf(types.ErrAccountNotFrozen, "the account %s is not frozen for the token %s", msg.Address.String(), msg.Symbol)
+	}
+
+	k.deleteFrozenAccount(ctx, token.Symbol, msg.Address)
+	return nil
+}
+
+// PauseToken pauses the specified token
+func (k Keeper) PauseToken(ctx sdk.Context, msg types.MsgPauseToken) error {
+	tokenI, err := k.GetToken(ctx, msg.Symbol)
+	if err != nil {
+		return err
+	}
+
+	token := tokenI.(*types.Token)
+
+	if err := k.checkRole(ctx, *token, msg.Owner, types.RoleAdmin); err != nil {
+		return err
+	}
+
+	if token.Paused {
+		return sdkerrors.Wrapf(types.ErrTokenPaused, "the token %s has been paused", msg.Symbol)
+	}
+
+	token.Paused = true
+	return k.updateToken(ctx, *token, msg.Owner, msg.Type())
+}
+
+// UnpauseToken unpauses the specified token
+func (k Keeper) UnpauseToken(ctx sdk.Context, msg types.MsgUnpauseToken) error {
+	tokenI, err := k.GetToken(ctx, msg.Symbol)
+	if err != nil {
+		return err
+	}
+
+	token := tokenI.(*types.Token)
+
+	if err := k.checkRole(ctx, *token, msg.Owner, types.RoleAdmin); err != nil {
+		return err
+	}
+
+	if !token.Paused {
+		return sdkerrors.Wrapf(types.ErrTokenNotPaused, "the token %s is not paused", msg.Symbol)
+	}
+
+	token.Paused = false
+	return k.updateToken(ctx, *token, msg.Owner, msg.Type())
+}
+
+// GrantMinter authorizes an address to mint the specified token up to a quota
+func (k Keeper) GrantMinter(ctx sdk.Context, msg types.MsgGrantMinter) error {
+	tokenI, err := k.GetToken(ctx, msg.Symbol)
+	if err != nil {
+		return err
+	}
+
+	token := tokenI.(*types.Token)
+
+	if err := k.checkRole(ctx, *token, msg.Owner, types.RoleAdmin); err != nil {
+		return err
+	}
+
+	if msg.Expiry != nil && !msg.Expiry.After(ctx.BlockTime()) {
+		return sdkerrors.Wrapf(types.ErrInvalidExpiry, "the expiry %s must be later than the current block time", msg.Expiry)
+	}
+
+	// a new grant replaces the existing one
+	k.SetMinter(ctx, types.Minter{
+		Symbol:  token.Symbol,
+		Address: msg.Minter,
+		Quota:   msg.Quota,
+		Expiry:  msg.Expiry,
+	})
+	return nil
+}
+
+// RevokeMinter revokes the authorization of the minter of the specified token
+func (k Keeper) RevokeMinter(ctx sdk.Context, msg types.MsgRevokeMinter) error {
+	tokenI, err := k.GetToken(ctx, msg.Symbol)
+	if err != nil {
+		return err
+	}
+
+	token := tokenI.(*types.Token)
+
+	if err := k.checkRole(ctx, *token, msg.Owner, types.RoleAdmin); err != nil {
+		return err
+	}
+
+	if _, err := k.GetMinter(ctx, token.Symbol, msg.Minter); err != nil {
+		return err
 	}
 
-	// sent coins to owner's account
-	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mintAcc, mintCoins); err != nil {
+	k.deleteMinter(ctx, token.Symbol, msg.Minter)
+	return nil
+}
+
+// GrantRole grants a role of the specified token to an address
+func (k Keeper) GrantRole(ctx sdk.Context, msg types.MsgGrantRole) error {
+	tokenI, err := k.GetToken(ctx, msg.Symbol)
+	if err != nil {
 		return err
 	}
 
+	token := tokenI.(*types.Token)
+
+	if err := k.checkRole(ctx, *token, msg.Owner, types.RoleAdmin); err != nil {
+		return err
+	}
+
+	if k.HasRole(ctx, token.Symbol, msg.Address, msg.Role) {
+		return sdkerrors.Wrapf(types.ErrInvalidRole, "the role %s of the token %s has been granted to the address %s", msg.Role, msg.Symbol, msg.Address.String())
+	}
+
+	k.SetRole(ctx, types.RoleGrant{
+		Symbol:  token.Symbol,
+		Address: msg.Address,
+		Role:    msg.Role,
+	})
+	return nil
+}
+
+// RevokeRole revokes a role of the specified token from an address
+func (k Keeper) RevokeRole(ctx sdk.Context, msg types.MsgRevokeRole) error {
+	tokenI, err := k.GetToken(ctx, msg.Symbol)
+	if err != nil {
+		return err
+	}
+
+	token := tokenI.(*types.Token)
+
+	if err := k.checkRole(ctx, *token, msg.Owner, types.RoleAdmin); err != nil {
+		return err
+	}
+
+	if !k.HasRole(ctx, token.Symbol, msg.Address, msg.Role) {
+		return sdkerrors.Wrapf(types.ErrRoleNotExists, "the role %s of the token %s is not granted to the address %s", msg.Role, msg.Symbol, msg.Address.String())
+	}
+
+	k.deleteRole(ctx, token.Symbol, msg.Address, msg.Role)
 	return nil
 }
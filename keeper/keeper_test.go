@@ -2,8 +2,14 @@ package keeper_test
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
 	"testing"
+	"time"
 
+	gogotypes "github.com/gogo/protobuf/types"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
@@ -11,10 +17,16 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
+	"github.com/irismod/token"
 	simapp "github.com/irismod/token/app"
 	"github.com/irismod/token/keeper"
+	v1 "github.com/irismod/token/legacy/v1"
 	"github.com/irismod/token/types"
 )
 
@@ -63,7 +75,7 @@ func TestKeeperSuite(t *testing.T) {
 }
 
 func (suite *KeeperTestSuite) TestIssueToken() {
-	msg := types.NewMsgIssueToken("btc", "satoshi", "Bitcoin Network", 18, 21000000, 21000000, false, owner)
+	msg := types.NewMsgIssueToken("btc", "satoshi", "Bitcoin Network", 18, sdk.NewInt(21000000), sdk.NewInt(21000000), false, owner)
 
 	err := suite.keeper.IssueToken(suite.ctx, *msg)
 	require.NoError(suite.T(), err)
@@ -76,7 +88,7 @@ func (suite *KeeperTestSuite) TestIssueToken() {
 	suite.Equal(msg.MinUnit, token.GetMinUnit())
 	suite.Equal(msg.Owner, token.GetOwner())
 
-	ftJson, _ := json.Marshal(msg)
+	ftJson, _ := json.Marshal(types.NewToken(msg.Symbol, msg.Name, msg.MinUnit, msg.Scale, msg.InitialSupply, msg.MaxSupply, msg.Mintable, msg.Owner))
 	tokenJson, _ := json.Marshal(token)
 	suite.Equal(ftJson, tokenJson)
 }
@@ -86,24 +98,42 @@ func (suite *KeeperTestSuite) TestEditToken() {
 	suite.TestIssueToken()
 
 	mintable := types.True
-	msgEditToken := types.NewMsgEditToken("Bitcoin Token", "btc", 22000000, mintable, owner)
+	msgEditToken := types.NewMsgEditToken("Bitcoin Token", "btc", sdk.NewInt(22000000), mintable, owner)
 	err := suite.keeper.EditToken(suite.ctx, *msgEditToken)
 	require.NoError(suite.T(), err)
 
 	token2, err := suite.keeper.GetToken(suite.ctx, msgEditToken.Symbol)
 	require.NoError(suite.T(), err)
 
-	expToken := types.NewToken("btc", "Bitcoin Token", "satoshi", 18, 21000000, 22000000, mintable.ToBool(), owner)
+	expToken := types.NewToken("btc", "Bitcoin Token", "satoshi", 18, sdk.NewInt(21000000), sdk.NewInt(22000000), mintable.ToBool(), owner)
 
 	expJson, _ := json.Marshal(expToken)
 	actJson, _ := json.Marshal(token2)
 	suite.Equal(expJson, actJson)
 
+	// only the specified metadata is modified
+	msgEditToken = types.NewMsgEditToken(types.DoNotModify, "btc", sdk.NewInt(0), types.Nil, owner)
+	msgEditToken.Website = "https://bitcoin.org"
+	msgEditToken.LogoURI = "https://bitcoin.org/logo.svg"
+	err = suite.keeper.EditToken(suite.ctx, *msgEditToken)
+	require.NoError(suite.T(), err)
+
+	msgEditToken = types.NewMsgEditToken(types.DoNotModify, "btc", sdk.NewInt(0), types.Nil, owner)
+	msgEditToken.LogoURI = ""
+	err = suite.keeper.EditToken(suite.ctx, *msgEditToken)
+	require.NoError(suite.T(), err)
+
+	token2, err = suite.keeper.GetToken(suite.ctx, "btc")
+	require.NoError(suite.T(), err)
+	suite.Equal("Bitcoin Token", token2.GetName())
+	suite.Equal("https://bitcoin.org", token2.GetWebsite())
+	suite.Empty(token2.GetLogoURI())
+	suite.Empty(token2.GetDescription())
 }
 
 func (suite *KeeperTestSuite) TestMintToken() {
 
-	msg := types.NewMsgIssueToken("btc", "satoshi", "Bitcoin Network", 18, 1000, 2000, true, owner)
+	msg := types.NewMsgIssueToken("btc", "satoshi", "Bitcoin Network", 18, sdk.NewInt(1000), sdk.NewInt(2000), true, owner)
 
 	err := suite.keeper.IssueToken(suite.ctx, *msg)
 	require.NoError(suite.T(), err)
@@ -113,7 +143,7 @@ func (suite *KeeperTestSuite) TestMintToken() {
 	amt := suite.bk.GetBalance(suite.ctx, owner, msg.MinUnit)
 	suite.Equal("1000000000000000000000satoshi", amt.String())
 
-	msgMintToken := types.NewMsgMintToken(msg.Symbol, owner, nil, 1000)
+	msgMintToken := types.NewMsgMintToken(msg.Symbol, owner, nil, sdk.NewInt(1000))
 	err = suite.keeper.MintToken(suite.ctx, *msgMintToken)
 	require.NoError(suite.T(), err)
 
@@ -134,7 +164,1274 @@ func (suite *KeeperTestSuite) TestTransferToken() {
 	err := suite.keeper.TransferTokenOwner(suite.ctx, msg)
 	require.NoError(suite.T(), err)
 
+	// the owner is unchanged until the transfer is accepted
 	token, err := suite.keeper.GetToken(suite.ctx, "btc")
 	require.NoError(suite.T(), err)
+	suite.Equal(owner, token.GetOwner())
+
+	transfers := suite.keeper.GetPendingTransfersByRecipient(suite.ctx, dstOwner)
+	suite.Len(transfers, 1)
+	suite.Equal("btc", transfers[0].Symbol)
+
+	// only the recipient can accept the transfer
+	err = suite.keeper.AcceptTokenOwner(suite.ctx, *types.NewMsgAcceptTokenOwner("btc", owner))
+	suite.Error(err)
+
+	err = suite.keeper.AcceptTokenOwner(suite.ctx, *types.NewMsgAcceptTokenOwner("btc", dstOwner))
+	require.NoError(suite.T(), err)
+
+	token, err = suite.keeper.GetToken(suite.ctx, "btc")
+	require.NoError(suite.T(), err)
 	suite.Equal(dstOwner, token.GetOwner())
+
+	suite.Empty(suite.keeper.GetAllPendingTransfers(suite.ctx))
+	suite.Len(suite.keeper.GetTokens(suite.ctx, dstOwner), 1)
+	suite.Empty(suite.keeper.GetTokens(suite.ctx, owner))
+}
+
+func (suite *KeeperTestSuite) TestCancelTransferToken() {
+	suite.TestIssueToken()
+
+	dstOwner := sdk.AccAddress([]byte("TokenDstOwner"))
+	err := suite.keeper.TransferTokenOwner(suite.ctx, *types.NewMsgTransferTokenOwner(owner, dstOwner, "btc"))
+	require.NoError(suite.T(), err)
+
+	// only the owner can cancel the transfer
+	err = suite.keeper.CancelTransferTokenOwner(suite.ctx, *types.NewMsgCancelTransferTokenOwner("btc", dstOwner))
+	suite.Error(err)
+
+	err = suite.keeper.CancelTransferTokenOwner(suite.ctx, *types.NewMsgCancelTransferTokenOwner("btc", owner))
+	require.NoError(suite.T(), err)
+
+	err = suite.keeper.AcceptTokenOwner(suite.ctx, *types.NewMsgAcceptTokenOwner("btc", dstOwner))
+	suite.Error(err)
+	suite.Empty(suite.keeper.GetPendingTransfersByRecipient(suite.ctx, dstOwner))
+}
+
+func (suite *KeeperTestSuite) TestExpireTransferToken() {
+	suite.TestIssueToken()
+
+	params := suite.keeper.GetParamSet(suite.ctx)
+	params.PendingTransferPeriod = 10
+	suite.keeper.SetParamSet(suite.ctx, params)
+
+	dstOwner := sdk.AccAddress([]byte("TokenDstOwner"))
+	err := suite.keeper.TransferTokenOwner(suite.ctx, *types.NewMsgTransferTokenOwner(owner, dstOwner, "btc"))
+	require.NoError(suite.T(), err)
+
+	expireHeight := suite.ctx.BlockHeight() + 10
+	suite.Empty(suite.keeper.GetExpiredPendingTransfers(suite.ctx, expireHeight-1))
+
+	expired := suite.keeper.GetExpiredPendingTransfers(suite.ctx, expireHeight)
+	suite.Len(expired, 1)
+
+	suite.keeper.RemovePendingTransfer(suite.ctx, expired[0])
+	suite.Empty(suite.keeper.GetAllPendingTransfers(suite.ctx))
+	suite.Empty(suite.keeper.GetExpiredPendingTransfers(suite.ctx, expireHeight))
+}
+
+func (suite *KeeperTestSuite) TestBurnToken() {
+	msg := types.NewMsgIssueToken("btc", "satoshi", "Bitcoin Network", 18, sdk.NewInt(1000), sdk.NewInt(2000), true, owner)
+
+	err := suite.keeper.IssueToken(suite.ctx, *msg)
+	require.NoError(suite.T(), err)
+
+	msgBurnToken := types.NewMsgBurnToken(msg.Symbol, owner, sdk.NewInt(200))
+	err = suite.keeper.BurnToken(suite.ctx, *msgBurnToken)
+	require.NoError(suite.T(), err)
+
+	amt := suite.bk.GetBalance(suite.ctx, owner, msg.MinUnit)
+	suite.Equal("800000000000000000000satoshi", amt.String())
+
+	burnedCoin := suite.keeper.GetBurnCoin(suite.ctx, msg.MinUnit)
+	suite.Equal("200000000000000000000satoshi", burnedCoin.String())
+
+	// the burned tokens are counted against the max supply
+	msgMintToken := types.NewMsgMintToken(msg.Symbol, owner, nil, sdk.NewInt(1001))
+	err = suite.keeper.MintToken(suite.ctx, *msgMintToken)
+	suite.Error(err)
+
+	msgMintToken = types.NewMsgMintToken(msg.Symbol, owner, nil, sdk.NewInt(1000))
+	err = suite.keeper.MintToken(suite.ctx, *msgMintToken)
+	require.NoError(suite.T(), err)
+
+	// burning more than the balance fails
+	msgBurnToken = types.NewMsgBurnToken(msg.Symbol, owner, sdk.NewInt(2000))
+	err = suite.keeper.BurnToken(suite.ctx, *msgBurnToken)
+	suite.Error(err)
+}
+
+func (suite *KeeperTestSuite) TestFreezeAccount() {
+	suite.TestIssueToken()
+
+	holder := sdk.AccAddress([]byte("tokenHolder"))
+
+	// only the owner can freeze an account
+	err := suite.keeper.FreezeAccount(suite.ctx, *types.NewMsgFreezeAccount("btc", holder, holder))
+	suite.Error(err)
+
+	err = suite.keeper.FreezeAccount(suite.ctx, *types.NewMsgFreezeAccount("btc", owner, holder))
+	require.NoError(suite.T(), err)
+	suite.True(suite.keeper.IsFrozenAccount(suite.ctx, "btc", holder))
+	suite.Equal([]sdk.AccAddress{holder}, suite.keeper.GetFrozenAccounts(suite.ctx, "btc"))
+
+	// transfers from or to the frozen account are rejected
+	decorator := keeper.NewValidateTokenTransferDecorator(suite.keeper)
+	next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) { return ctx, nil }
+
+	sendMsg := banktypes.NewMsgSend(owner, holder, sdk.NewCoins(sdk.NewCoin("satoshi", sdk.NewInt(1))))
+	_, err = decorator.AnteHandle(suite.ctx, testTx{sendMsg}, false, next)
+	suite.Error(err)
+
+	_, err = decorator.AnteHandle(suite.ctx, testTx{testMsgWrapper{sendMsg}}, false, next)
+	suite.Error(err)
+
+	sendMsg = banktypes.NewMsgSend(owner, holder, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(1))))
+	_, err = decorator.AnteHandle(suite.ctx, testTx{sendMsg}, false, next)
+	suite.NoError(err)
+
+	// the bank keeper rejects the transfers and the mints to the frozen account as well
+	err = suite.bk.SendCoins(suite.ctx, owner, holder, sdk.NewCoins(sdk.NewCoin("satoshi", sdk.NewInt(1))))
+	suite.Error(err)
+
+	err = suite.keeper.MintToken(suite.ctx, *types.NewMsgMintToken("btc", owner, holder, sdk.NewInt(1)))
+	suite.Error(err)
+
+	// freezing a frozen account fails
+	err = suite.keeper.FreezeAccount(suite.ctx, *types.NewMsgFreezeAccount("btc", owner, holder))
+	suite.Error(err)
+
+	err = suite.keeper.UnfreezeAccount(suite.ctx, *types.NewMsgUnfreezeAccount("btc", owner, holder))
+	require.NoError(suite.T(), err)
+	suite.False(suite.keeper.IsFrozenAccount(suite.ctx, "btc", holder))
+
+	sendMsg = banktypes.NewMsgSend(owner, holder, sdk.NewCoins(sdk.NewCoin("satoshi", sdk.NewInt(1))))
+	_, err = decorator.AnteHandle(suite.ctx, testTx{sendMsg}, false, next)
+	suite.NoError(err)
+
+	// unfreezing an account which is not frozen fails
+	err = suite.keeper.UnfreezeAccount(suite.ctx, *types.NewMsgUnfreezeAccount("btc", owner, holder))
+	suite.Error(err)
+}
+
+// testTx is a minimal sdk.Tx carrying the given msgs
+type testTx []sdk.Msg
+
+func (tx testTx) GetMsgs() []sdk.Msg   { return tx }
+func (tx testTx) ValidateBasic() error { return nil }
+
+func (suite *KeeperTestSuite) TestPauseToken() {
+	msg := types.NewMsgIssueToken("btc", "satoshi", "Bitcoin Network", 18, sdk.NewInt(1000), sdk.NewInt(2000), true, owner)
+
+	err := suite.keeper.IssueToken(suite.ctx, *msg)
+	require.NoError(suite.T(), err)
+
+	// only the owner can pause the token
+	err = suite.keeper.PauseToken(suite.ctx, *types.NewMsgPauseToken("btc", sdk.AccAddress([]byte("tokenHolder"))))
+	suite.Error(err)
+
+	err = suite.keeper.PauseToken(suite.ctx, *types.NewMsgPauseToken("btc", owner))
+	require.NoError(suite.T(), err)
+
+	token, err := suite.keeper.GetToken(suite.ctx, "btc")
+	require.NoError(suite.T(), err)
+	suite.True(token.GetPaused())
+
+	// mints, burns and transfers are rejected while paused
+	err = suite.keeper.MintToken(suite.ctx, *types.NewMsgMintToken("btc", owner, nil, sdk.NewInt(1)))
+	suite.Error(err)
+
+	err = suite.keeper.BurnToken(suite.ctx, *types.NewMsgBurnToken("btc", owner, sdk.NewInt(1)))
+	suite.Error(err)
+
+	decorator := keeper.NewValidateTokenTransferDecorator(suite.keeper)
+	next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) { return ctx, nil }
+
+	sendMsg := banktypes.NewMsgSend(owner, sdk.AccAddress([]byte("tokenHolder")), sdk.NewCoins(sdk.NewCoin("satoshi", sdk.NewInt(1))))
+	_, err = decorator.AnteHandle(suite.ctx, testTx{sendMsg}, false, next)
+	suite.Error(err)
+	_, err = decorator.AnteHandle(suite.ctx, testTx{testMsgWrapper{sendMsg}}, false, next)
+	suite.Error(err)
+
+	// the bank keeper rejects the transfers of the other modules as well
+	err = suite.bk.SendCoins(suite.ctx, owner, sdk.AccAddress([]byte("tokenHolder")), sendMsg.Amount)
+	suite.Error(err)
+	err = suite.bk.SendCoinsFromAccountToModule(suite.ctx, owner, types.ModuleName, sendMsg.Amount)
+	suite.Error(err)
+
+	err = suite.keeper.UnpauseToken(suite.ctx, *types.NewMsgUnpauseToken("btc", owner))
+	require.NoError(suite.T(), err)
+
+	err = suite.keeper.MintToken(suite.ctx, *types.NewMsgMintToken("btc", owner, nil, sdk.NewInt(1)))
+	suite.NoError(err)
+
+	_, err = decorator.AnteHandle(suite.ctx, testTx{sendMsg}, false, next)
+	suite.NoError(err)
+
+	// unpausing a token which is not paused fails
+	err = suite.keeper.UnpauseToken(suite.ctx, *types.NewMsgUnpauseToken("btc", owner))
+	suite.Error(err)
+}
+
+func (suite *KeeperTestSuite) TestMinter() {
+	msg := types.NewMsgIssueToken("btc", "satoshi", "Bitcoin Network", 18, sdk.NewInt(1000), sdk.NewInt(2000), true, owner)
+
+	err := suite.keeper.IssueToken(suite.ctx, *msg)
+	require.NoError(suite.T(), err)
+
+	minter := sdk.AccAddress([]byte("tokenMinter"))
+
+	// a minter without any grant is rejected
+	err = suite.keeper.MintToken(suite.ctx, *types.NewMsgMintToken("btc", minter, nil, sdk.NewInt(100)))
+	suite.Error(err)
+
+	expiry := suite.ctx.BlockTime().Add(time.Hour)
+	err = suite.keeper.GrantMinter(suite.ctx, *types.NewMsgGrantMinter("btc", owner, minter, sdk.NewInt(300), &expiry))
+	require.NoError(suite.T(), err)
+
+	err = suite.keeper.MintToken(suite.ctx, *types.NewMsgMintToken("btc", minter, minter, sdk.NewInt(100)))
+	require.NoError(suite.T(), err)
+
+	amt := suite.bk.GetBalance(suite.ctx, minter, msg.MinUnit)
+	suite.Equal("100000000000000000000satoshi", amt.String())
+
+	m, err := suite.keeper.GetMinter(suite.ctx, "btc", minter)
+	require.NoError(suite.T(), err)
+	suite.Equal(sdk.NewInt(200), m.Quota)
+
+	// minting more than the remaining quota fails
+	err = suite.keeper.MintToken(suite.ctx, *types.NewMsgMintToken("btc", minter, minter, sdk.NewInt(201)))
+	suite.Error(err)
+
+	// an expired minter is rejected
+	expiredCtx := suite.ctx.WithBlockTime(expiry)
+	err = suite.keeper.MintToken(expiredCtx, *types.NewMsgMintToken("btc", minter, minter, sdk.NewInt(1)))
+	suite.Error(err)
+
+	// the minter is removed once the quota is used up
+	err = suite.keeper.MintToken(suite.ctx, *types.NewMsgMintToken("btc", minter, minter, sdk.NewInt(200)))
+	require.NoError(suite.T(), err)
+	suite.Empty(suite.keeper.GetMinters(suite.ctx, "btc"))
+
+	err = suite.keeper.GrantMinter(suite.ctx, *types.NewMsgGrantMinter("btc", owner, minter, sdk.NewInt(300), nil))
+	require.NoError(suite.T(), err)
+	suite.Len(suite.keeper.GetMinters(suite.ctx, "btc"), 1)
+
+	err = suite.keeper.RevokeMinter(suite.ctx, *types.NewMsgRevokeMinter("btc", owner, minter))
+	require.NoError(suite.T(), err)
+
+	err = suite.keeper.MintToken(suite.ctx, *types.NewMsgMintToken("btc", minter, minter, sdk.NewInt(1)))
+	suite.Error(err)
+}
+
+func (suite *KeeperTestSuite) TestMultiMint() {
+	msg := types.NewMsgIssueToken("btc", "satoshi", "Bitcoin Network", 18, sdk.NewInt(1000), sdk.NewInt(2000), true, owner)
+
+	err := suite.keeper.IssueToken(suite.ctx, *msg)
+	require.NoError(suite.T(), err)
+
+	minter := sdk.AccAddress([]byte("tokenMinter"))
+	recipient1 := sdk.AccAddress([]byte("tokenRecipient1"))
+	recipient2 := sdk.AccAddress([]byte("tokenRecipient2"))
+	recipients := []types.MintRecipient{{Address: recipient1, Amount: sdk.NewInt(100)}, {Address: recipient2, Amount: sdk.NewInt(200)}}
+
+	err = suite.keeper.MultiMint(suite.ctx, *types.NewMsgMultiMint("btc", owner, recipients))
+	require.NoError(suite.T(), err)
+
+	suite.Equal("100000000000000000000satoshi", suite.bk.GetBalance(suite.ctx, recipient1, msg.MinUnit).String())
+	suite.Equal("200000000000000000000satoshi", suite.bk.GetBalance(suite.ctx, recipient2, msg.MinUnit).String())
+
+	// the quota of a minter is checked against the total amount
+	err = suite.keeper.GrantMinter(suite.ctx, *types.NewMsgGrantMinter("btc", owner, minter, sdk.NewInt(250), nil))
+	require.NoError(suite.T(), err)
+
+	err = suite.keeper.MultiMint(suite.ctx, *types.NewMsgMultiMint("btc", minter, recipients))
+	suite.Error(err)
+
+	recipients[1].Amount = sdk.NewInt(150)
+	err = suite.keeper.MultiMint(suite.ctx, *types.NewMsgMultiMint("btc", minter, recipients))
+	require.NoError(suite.T(), err)
+	suite.Empty(suite.keeper.GetMinters(suite.ctx, "btc"))
+
+	// the total amount must not exceed the max supply
+	recipients = []types.MintRecipient{{Address: recipient1, Amount: sdk.NewInt(300)}, {Address: recipient2, Amount: sdk.NewInt(151)}}
+	err = suite.keeper.MultiMint(suite.ctx, *types.NewMsgMultiMint("btc", owner, recipients))
+	suite.Error(err)
+}
+
+func (suite *KeeperTestSuite) TestMultiMintFee() {
+	suite.TestIssueToken()
+
+	mintFee := suite.keeper.GetTokenMintFee(suite.ctx, "btc")
+	suite.Equal(mintFee, suite.keeper.GetTokenMultiMintFee(suite.ctx, "btc", 3))
+
+	params := suite.keeper.GetParamSet(suite.ctx)
+	params.MultiMintFeeRatio = sdk.NewDecWithPrec(5, 1)
+	suite.keeper.SetParamSet(suite.ctx, params)
+
+	suite.Equal(mintFee, suite.keeper.GetTokenMultiMintFee(suite.ctx, "btc", 1))
+	suite.True(mintFee.Amount.MulRaw(2).Equal(suite.keeper.GetTokenMultiMintFee(suite.ctx, "btc", 3).Amount))
+}
+
+func (suite *KeeperTestSuite) TestFeeDenom() {
+	params := suite.keeper.GetParamSet(suite.ctx)
+	params.FeeDenoms = []types.FeeDenom{{Denom: "usdc", Rate: sdk.NewDec(2)}}
+	suite.keeper.SetParamSet(suite.ctx, params)
+
+	usdc := sdk.NewCoins(sdk.NewCoin("usdc", initAmt))
+	err := suite.bk.MintCoins(suite.ctx, types.ModuleName, usdc)
+	suite.NoError(err)
+	err = suite.bk.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, owner, usdc)
+	suite.NoError(err)
+
+	issueFee := suite.keeper.GetTokenIssueFee(suite.ctx, "btc")
+	fee, err := suite.keeper.ConvertFee(suite.ctx, issueFee, "usdc")
+	require.NoError(suite.T(), err)
+	suite.Equal(sdk.NewCoin("usdc", issueFee.Amount.MulRaw(2)), fee)
+
+	// the fee in the base denom is unchanged
+	fee, err = suite.keeper.ConvertFee(suite.ctx, issueFee, "")
+	require.NoError(suite.T(), err)
+	suite.Equal(issueFee, fee)
+
+	// the fee is deducted in the fee denom and the base denom is untouched
+	msg := types.NewMsgIssueToken("btc", "satoshi", "Bitcoin Network", 18, sdk.NewInt(1000), sdk.NewInt(2000), true, owner)
+	msg.FeeDenom = "usdc"
+	payer, fee, err := suite.keeper.GetMsgTokenFee(suite.ctx, msg)
+	require.NoError(suite.T(), err)
+	suite.Equal(owner, payer)
+
+	err = suite.keeper.DeductTokenFees(suite.ctx, payer, sdk.NewCoins(fee))
+	require.NoError(suite.T(), err)
+	suite.Equal(initAmt.Sub(issueFee.Amount.MulRaw(2)), suite.bk.GetBalance(suite.ctx, owner, "usdc").Amount)
+	suite.Equal(initAmt, suite.bk.GetBalance(suite.ctx, owner, denom).Amount)
+
+	// a denom not whitelisted is rejected
+	msg.FeeDenom = "atom"
+	_, _, err = suite.keeper.GetMsgTokenFee(suite.ctx, msg)
+	suite.Error(err)
+}
+
+func (suite *KeeperTestSuite) TestValidateTokenFee() {
+	decorator := keeper.NewValidateTokenFeeDecorator(suite.keeper)
+	next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) { return ctx, nil }
+
+	holder := sdk.AccAddress([]byte("tokenHolder"))
+	issueMsg := types.NewMsgIssueToken("btc", "satoshi", "Bitcoin Network", 18, sdk.NewInt(1000), sdk.NewInt(2000), true, owner)
+	issueFee := suite.keeper.GetTokenIssueFee(suite.ctx, "btc")
+
+	// the token msgs after a msg of another module are checked, including the nested ones, while nothing is deducted
+	sendMsg := banktypes.NewMsgSend(owner, holder, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(1))))
+	mintMsg := types.NewMsgMintToken("btc", owner, nil, sdk.NewInt(100))
+	_, err := decorator.AnteHandle(suite.ctx, testTx{sendMsg, issueMsg, testMsgWrapper{mintMsg}}, false, next)
+	require.NoError(suite.T(), err)
+	suite.Equal(initAmt, suite.bk.GetBalance(suite.ctx, owner, denom).Amount)
+
+	// the coins sent away by the bank msgs of the tx, including the nested ones, can not pay the fees
+	allCoins := sdk.NewCoins(sdk.NewCoin(denom, initAmt))
+	multiSendMsg := banktypes.NewMsgMultiSend([]banktypes.Input{banktypes.NewInput(owner, allCoins)}, []banktypes.Output{banktypes.NewOutput(holder, allCoins)})
+	_, err = decorator.AnteHandle(suite.ctx, testTx{testMsgWrapper{banktypes.NewMsgSend(owner, holder, allCoins)}, issueMsg}, false, next)
+	suite.True(sdkerrors.ErrInsufficientFunds.Is(err))
+	_, err = decorator.AnteHandle(suite.ctx, testTx{issueMsg, multiSendMsg}, false, next)
+	suite.True(sdkerrors.ErrInsufficientFunds.Is(err))
+
+	// the fees are aggregated before the balance is checked
+	err = suite.bk.SendCoins(suite.ctx, owner, holder, sdk.NewCoins(sdk.NewCoin(denom, initAmt.Sub(issueFee.Amount))))
+	require.NoError(suite.T(), err)
+
+	_, err = decorator.AnteHandle(suite.ctx, testTx{issueMsg}, false, next)
+	require.NoError(suite.T(), err)
+	_, err = decorator.AnteHandle(suite.ctx, testTx{issueMsg, mintMsg}, false, next)
+	suite.True(sdkerrors.ErrInsufficientFunds.Is(err))
+
+	// the fee is charged by the handler along with the msg
+	err = suite.keeper.DeductMsgTokenFee(suite.ctx, issueMsg)
+	require.NoError(suite.T(), err)
+	suite.True(suite.bk.GetBalance(suite.ctx, owner, denom).IsZero())
+
+	_, err = decorator.AnteHandle(suite.ctx, testTx{issueMsg}, false, next)
+	suite.True(sdkerrors.ErrInsufficientFunds.Is(err))
+
+	// no renewal is charged while the symbol leases are disabled
+	renewMsg := types.NewMsgRenewSymbol("btc", owner)
+	payer, _, err := suite.keeper.GetMsgTokenFee(suite.ctx, renewMsg)
+	require.NoError(suite.T(), err)
+	suite.True(payer.Empty())
+	err = suite.keeper.DeductMsgTokenFee(suite.ctx, renewMsg)
+	require.NoError(suite.T(), err)
+}
+
+// testMsgWrapper is a minimal keeper.MsgWrapper wrapping the given msgs
+type testMsgWrapper []sdk.Msg
+
+func (msg testMsgWrapper) Route() string                   { return "wrapper" }
+func (msg testMsgWrapper) Type() string                    { return "wrapper" }
+func (msg testMsgWrapper) ValidateBasic() error            { return nil }
+func (msg testMsgWrapper) GetSignBytes() []byte            { return nil }
+func (msg testMsgWrapper) GetSigners() []sdk.AccAddress    { return nil }
+func (msg testMsgWrapper) GetMessages() ([]sdk.Msg, error) { return msg, nil }
+func (msg testMsgWrapper) Reset()                          {}
+func (msg testMsgWrapper) String() string                  { return "wrapper" }
+func (msg testMsgWrapper) ProtoMessage()                   {}
+
+func (suite *KeeperTestSuite) TestRole() {
+	msg := types.NewMsgIssueToken("btc", "satoshi", "Bitcoin Network", 18, sdk.NewInt(1000), sdk.NewInt(2000), true, owner)
+
+	err := suite.keeper.IssueToken(suite.ctx, *msg)
+	require.NoError(suite.T(), err)
+
+	admin := sdk.AccAddress([]byte("tokenAdmin"))
+	editor := sdk.AccAddress([]byte("tokenEditor"))
+	freezer := sdk.AccAddress([]byte("tokenFreezer"))
+
+	// an address without any role is rejected
+	err = suite.keeper.GrantRole(suite.ctx, *types.NewMsgGrantRole("btc", admin, editor, types.RoleMetadataEditor))
+	suite.Error(err)
+
+	err = suite.keeper.GrantRole(suite.ctx, *types.NewMsgGrantRole("btc", owner, admin, types.RoleAdmin))
+	require.NoError(suite.T(), err)
+
+	// the admin manages the other roles
+	err = suite.keeper.GrantRole(suite.ctx, *types.NewMsgGrantRole("btc", admin, editor, types.RoleMetadataEditor))
+	require.NoError(suite.T(), err)
+	err = suite.keeper.GrantRole(suite.ctx, *types.NewMsgGrantRole("btc", admin, freezer, types.RoleFreezer))
+	require.NoError(suite.T(), err)
+	suite.Len(suite.keeper.GetRoles(suite.ctx, "btc"), 3)
+
+	// each role only allows its own duty
+	err = suite.keeper.EditToken(suite.ctx, *types.NewMsgEditToken("Bitcoin", "btc", sdk.NewInt(0), types.Nil, editor))
+	require.NoError(suite.T(), err)
+	err = suite.keeper.EditToken(suite.ctx, *types.NewMsgEditToken("Bitcoin", "btc", sdk.NewInt(0), types.Nil, freezer))
+	suite.Error(err)
+
+	// the supply settings are edited by the admin only
+	err = suite.keeper.EditToken(suite.ctx, *types.NewMsgEditToken(types.DoNotModify, "btc", sdk.NewInt(3000), types.Nil, editor))
+	suite.Error(err)
+	err = suite.keeper.EditToken(suite.ctx, *types.NewMsgEditToken(types.DoNotModify, "btc", sdk.NewInt(0), types.False, editor))
+	suite.Error(err)
+	err = suite.keeper.EditToken(suite.ctx, *types.NewMsgEditToken("Bitcoin Token", "btc", sdk.NewInt(3000), types.Nil, admin))
+	suite.Error(err)
+	err = suite.keeper.EditToken(suite.ctx, *types.NewMsgEditToken(types.DoNotModify, "btc", sdk.NewInt(3000), types.True, admin))
+	require.NoError(suite.T(), err)
+
+	err = suite.keeper.FreezeAccount(suite.ctx, *types.NewMsgFreezeAccount("btc", freezer, editor))
+	require.NoError(suite.T(), err)
+	err = suite.keeper.FreezeAccount(suite.ctx, *types.NewMsgFreezeAccount("btc", editor, freezer))
+	suite.Error(err)
+
+	err = suite.keeper.PauseToken(suite.ctx, *types.NewMsgPauseToken("btc", freezer))
+	suite.Error(err)
+	err = suite.keeper.PauseToken(suite.ctx, *types.NewMsgPauseToken("btc", admin))
+	require.NoError(suite.T(), err)
+	err = suite.keeper.UnpauseToken(suite.ctx, *types.NewMsgUnpauseToken("btc", admin))
+	require.NoError(suite.T(), err)
+
+	// the minter role mints without a quota
+	err = suite.keeper.MintToken(suite.ctx, *types.NewMsgMintToken("btc", admin, admin, sdk.NewInt(100)))
+	suite.Error(err)
+	err = suite.keeper.GrantRole(suite.ctx, *types.NewMsgGrantRole("btc", admin, admin, types.RoleMinter))
+	require.NoError(suite.T(), err)
+	err = suite.keeper.MintToken(suite.ctx, *types.NewMsgMintToken("btc", admin, admin, sdk.NewInt(100)))
+	require.NoError(suite.T(), err)
+
+	err = suite.keeper.RevokeRole(suite.ctx, *types.NewMsgRevokeRole("btc", admin, editor, types.RoleMetadataEditor))
+	require.NoError(suite.T(), err)
+	err = suite.keeper.EditToken(suite.ctx, *types.NewMsgEditToken("Bitcoin", "btc", sdk.NewInt(0), types.Nil, editor))
+	suite.Error(err)
+	err = suite.keeper.RevokeRole(suite.ctx, *types.NewMsgRevokeRole("btc", admin, editor, types.RoleMetadataEditor))
+	suite.Error(err)
+}
+
+func (suite *KeeperTestSuite) TestVesting() {
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := suite.ctx.WithBlockTime(start)
+
+	investor := sdk.AccAddress([]byte("tokenInvestor"))
+	cliff := start.Add(25 * time.Hour)
+	msg := types.NewMsgIssueToken("btc", "satoshi", "Bitcoin Network", 0, sdk.NewInt(1000), sdk.NewInt(2000), true, owner)
+	msg.Allocations = []types.VestingAllocation{{
+		Recipient: investor,
+		Amount:    sdk.NewInt(400),
+		CliffTime: &cliff,
+		EndTime:   start.Add(100 * time.Hour),
+	}}
+
+	err := suite.keeper.IssueToken(ctx, *msg)
+	require.NoError(suite.T(), err)
+
+	suite.Equal("600satoshi", suite.bk.GetBalance(ctx, owner, msg.MinUnit).String())
+
+	// nothing is released before the cliff
+	ctx = ctx.WithBlockTime(start.Add(24 * time.Hour))
+	token.EndBlocker(ctx, suite.keeper)
+	suite.True(suite.bk.GetBalance(ctx, investor, msg.MinUnit).IsZero())
+
+	balances := suite.keeper.GetVestingBalances(ctx, investor, "")
+	suite.Len(balances, 1)
+	suite.Equal("0satoshi", balances[0].Vested.String())
+	suite.Equal("400satoshi", balances[0].Unvested.String())
+
+	// the vested amount is only released on claim before the end time
+	ctx = ctx.WithBlockTime(start.Add(50 * time.Hour))
+	token.EndBlocker(ctx, suite.keeper)
+	suite.True(suite.bk.GetBalance(ctx, investor, msg.MinUnit).IsZero())
+	suite.Empty(suite.keeper.GetEndedVestingSchedules(ctx, ctx.BlockTime()))
+
+	// the release is rejected while the recipient is frozen
+	err = suite.keeper.FreezeAccount(ctx, *types.NewMsgFreezeAccount("btc", owner, investor))
+	require.NoError(suite.T(), err)
+	_, err = suite.keeper.ReleaseVesting(ctx, *types.NewMsgReleaseVesting("btc", investor))
+	suite.Error(err)
+
+	// the amount vests linearly since the issuance
+	err = suite.keeper.UnfreezeAccount(ctx, *types.NewMsgUnfreezeAccount("btc", owner, investor))
+	require.NoError(suite.T(), err)
+	released, err := suite.keeper.ReleaseVesting(ctx, *types.NewMsgReleaseVesting("btc", investor))
+	require.NoError(suite.T(), err)
+	suite.Equal("200satoshi", released.String())
+	suite.Equal("200satoshi", suite.bk.GetBalance(ctx, investor, msg.MinUnit).String())
+
+	_, err = suite.keeper.ReleaseVesting(ctx, *types.NewMsgReleaseVesting("btc", investor))
+	suite.Error(err)
+
+	balances = suite.keeper.GetVestingBalances(ctx, investor, "btc")
+	suite.Equal("200satoshi", balances[0].Vested.String())
+	suite.Equal("200satoshi", balances[0].Unvested.String())
+
+	// the release at the end time is skipped while the token is paused, and stays queued to be retried
+	ctx = ctx.WithBlockTime(start.Add(200 * time.Hour))
+	suite.Len(suite.keeper.GetEndedVestingSchedules(ctx, ctx.BlockTime()), 1)
+	err = suite.keeper.PauseToken(ctx, *types.NewMsgPauseToken("btc", owner))
+	require.NoError(suite.T(), err)
+	token.EndBlocker(ctx, suite.keeper)
+	suite.Equal("200satoshi", suite.bk.GetBalance(ctx, investor, msg.MinUnit).String())
+	suite.Len(suite.keeper.GetEndedVestingSchedules(ctx, ctx.BlockTime()), 1)
+
+	// the retried release can also be claimed and the schedule is removed once fully released
+	err = suite.keeper.UnpauseToken(ctx, *types.NewMsgUnpauseToken("btc", owner))
+	require.NoError(suite.T(), err)
+	released, err = suite.keeper.ReleaseVesting(ctx, *types.NewMsgReleaseVesting("btc", investor))
+	require.NoError(suite.T(), err)
+	suite.Equal("200satoshi", released.String())
+	suite.Equal("400satoshi", suite.bk.GetBalance(ctx, investor, msg.MinUnit).String())
+	suite.Empty(suite.keeper.GetAllVestingSchedules(ctx))
+}
+
+func (suite *KeeperTestSuite) TestVestingQueue() {
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := suite.ctx.WithBlockTime(start)
+
+	investor := sdk.AccAddress([]byte("tokenInvestor"))
+	msg := types.NewMsgIssueToken("btc", "satoshi", "Bitcoin Network", 0, sdk.NewInt(1000), sdk.NewInt(2000), true, owner)
+	msg.Allocations = []types.VestingAllocation{{
+		Recipient: investor,
+		Amount:    sdk.NewInt(400),
+		EndTime:   start.Add(100 * time.Hour),
+	}}
+
+	err := suite.keeper.IssueToken(ctx, *msg)
+	require.NoError(suite.T(), err)
+
+	suite.Empty(suite.keeper.GetEndedVestingSchedules(ctx, start.Add(100*time.Hour-time.Nanosecond)))
+	suite.Len(suite.keeper.GetEndedVestingSchedules(ctx, start.Add(100*time.Hour)), 1)
+
+	// the release is retried by the following blocks while the recipient is frozen
+	err = suite.keeper.FreezeAccount(ctx, *types.NewMsgFreezeAccount("btc", owner, investor))
+	require.NoError(suite.T(), err)
+	ctx = ctx.WithBlockTime(start.Add(100 * time.Hour))
+	token.EndBlocker(ctx, suite.keeper)
+	suite.True(suite.bk.GetBalance(ctx, investor, msg.MinUnit).IsZero())
+	suite.Len(suite.keeper.GetEndedVestingSchedules(ctx, ctx.BlockTime()), 1)
+
+	// the schedule is released in full once it ends and the recipient is unfrozen
+	err = suite.keeper.UnfreezeAccount(ctx, *types.NewMsgUnfreezeAccount("btc", owner, investor))
+	require.NoError(suite.T(), err)
+	ctx = ctx.WithBlockTime(start.Add(101 * time.Hour))
+	token.EndBlocker(ctx, suite.keeper)
+	suite.Equal("400satoshi", suite.bk.GetBalance(ctx, investor, msg.MinUnit).String())
+	suite.Empty(suite.keeper.GetAllVestingSchedules(ctx))
+	suite.Empty(suite.keeper.GetEndedVestingSchedules(ctx, ctx.BlockTime()))
+}
+
+func (suite *KeeperTestSuite) TestRetireToken() {
+	msg := types.NewMsgIssueToken("btc", "satoshi", "Bitcoin Network", 18, sdk.NewInt(1000), sdk.NewInt(2000), true, owner)
+
+	err := suite.keeper.IssueToken(suite.ctx, *msg)
+	require.NoError(suite.T(), err)
+
+	admin := sdk.AccAddress([]byte("tokenAdmin"))
+	err = suite.keeper.GrantRole(suite.ctx, *types.NewMsgGrantRole("btc", owner, admin, types.RoleAdmin))
+	require.NoError(suite.T(), err)
+	err = suite.keeper.GrantMinter(suite.ctx, *types.NewMsgGrantMinter("btc", owner, admin, sdk.NewInt(100), nil))
+	require.NoError(suite.T(), err)
+
+	// only the owner and the admins retire the token
+	err = suite.keeper.RetireToken(suite.ctx, *types.NewMsgRetireToken("btc", sdk.AccAddress([]byte("tokenHolder"))))
+	suite.True(types.ErrInvalidOwner.Is(err))
+
+	// the total supply must be zero
+	err = suite.keeper.RetireToken(suite.ctx, *types.NewMsgRetireToken("btc", owner))
+	suite.True(types.ErrNonZeroSupply.Is(err))
+
+	err = suite.keeper.BurnToken(suite.ctx, *types.NewMsgBurnToken("btc", owner, sdk.NewInt(1000)))
+	require.NoError(suite.T(), err)
+
+	suite.ctx = suite.ctx.WithBlockHeight(10)
+	err = suite.keeper.RetireToken(suite.ctx, *types.NewMsgRetireToken("btc", admin))
+	require.NoError(suite.T(), err)
+
+	suite.False(suite.keeper.HasToken(suite.ctx, "btc"))
+	suite.False(suite.keeper.HasToken(suite.ctx, "satoshi"))
+	suite.Empty(suite.keeper.GetTokens(suite.ctx, owner))
+	suite.Empty(suite.keeper.GetRoles(suite.ctx, "btc"))
+	suite.Empty(suite.keeper.GetMinters(suite.ctx, "btc"))
+	suite.True(suite.keeper.GetBurnCoin(suite.ctx, "satoshi").IsZero())
+
+	retired, err := suite.keeper.GetRetiredToken(suite.ctx, "btc")
+	require.NoError(suite.T(), err)
+	suite.Equal(int64(10), retired.RetireHeight)
+
+	// neither the symbol nor the min_unit can be re-registered within the cooldown
+	cooldown := int64(suite.keeper.GetParamSet(suite.ctx).RetiredSymbolCooldown)
+	suite.ctx = suite.ctx.WithBlockHeight(10 + cooldown - 1)
+
+	err = suite.keeper.IssueToken(suite.ctx, *msg)
+	suite.Error(err)
+	err = suite.keeper.IssueToken(suite.ctx, *types.NewMsgIssueToken("bitcoin", "satoshi", "Bitcoin Network", 18, sdk.NewInt(1000), sdk.NewInt(2000), true, owner))
+	suite.Error(err)
+
+	suite.ctx = suite.ctx.WithBlockHeight(10 + cooldown)
+	err = suite.keeper.IssueToken(suite.ctx, *msg)
+	require.NoError(suite.T(), err)
+
+	suite.Empty(suite.keeper.GetAllRetiredTokens(suite.ctx))
+	suite.Empty(suite.keeper.GetRoles(suite.ctx, "btc"))
+}
+
+func (suite *KeeperTestSuite) TestSnapshot() {
+	msg := types.NewMsgIssueToken("btc", "satoshi", "Bitcoin Network", 0, sdk.NewInt(1000), sdk.NewInt(2000), true, owner)
+
+	err := suite.keeper.IssueToken(suite.ctx, *msg)
+	require.NoError(suite.T(), err)
+
+	holder := sdk.AccAddress([]byte("tokenHolder"))
+
+	// only the owner or an admin takes a snapshot
+	_, err = suite.keeper.SnapshotToken(suite.ctx, *types.NewMsgSnapshotToken("btc", holder))
+	suite.Error(err)
+
+	_, err = suite.keeper.GetBalanceAt(suite.ctx, "btc", 1, owner)
+	suite.Error(err)
+
+	id1, err := suite.keeper.SnapshotToken(suite.ctx, *types.NewMsgSnapshotToken("btc", owner))
+	require.NoError(suite.T(), err)
+	suite.Equal(uint64(1), id1)
+
+	// the bank keeper records the balances before the transfer
+	coins := sdk.NewCoins(sdk.NewCoin("satoshi", sdk.NewInt(300)))
+	err = suite.bk.SendCoins(suite.ctx, owner, holder, coins)
+	require.NoError(suite.T(), err)
+
+	id2, err := suite.keeper.SnapshotToken(suite.ctx, *types.NewMsgSnapshotToken("btc", owner))
+	require.NoError(suite.T(), err)
+	suite.Equal(uint64(2), id2)
+
+	err = suite.keeper.MintToken(suite.ctx, *types.NewMsgMintToken("btc", owner, holder, sdk.NewInt(100)))
+	require.NoError(suite.T(), err)
+	err = suite.keeper.BurnToken(suite.ctx, *types.NewMsgBurnToken("btc", owner, sdk.NewInt(200)))
+	require.NoError(suite.T(), err)
+
+	// only the first change after a snapshot is recorded
+	err = suite.keeper.MintToken(suite.ctx, *types.NewMsgMintToken("btc", owner, holder, sdk.NewInt(100)))
+	require.NoError(suite.T(), err)
+
+	expected := []struct {
+		id      uint64
+		addr    sdk.AccAddress
+		balance int64
+	}{
+		{id1, owner, 1000},
+		{id1, holder, 0},
+		{id2, owner, 700},
+		{id2, holder, 300},
+		{id2, authtypes.NewModuleAddress(types.ModuleName), 0},
+	}
+	for _, e := range expected {
+		balance, err := suite.keeper.GetBalanceAt(suite.ctx, "btc", e.id, e.addr)
+		require.NoError(suite.T(), err)
+		suite.Equal(sdk.NewCoin("satoshi", sdk.NewInt(e.balance)), balance)
+	}
+
+	suite.Equal("500satoshi", suite.bk.GetBalance(suite.ctx, owner, "satoshi").String())
+	suite.Equal("500satoshi", suite.bk.GetBalance(suite.ctx, holder, "satoshi").String())
+	// the balance of the module account which mints and burns the tokens is recorded as well
+	suite.Len(suite.keeper.GetAllSnapshotBalances(suite.ctx), 5)
+
+	_, err = suite.keeper.GetBalanceAt(suite.ctx, "btc", 3, owner)
+	suite.Error(err)
+}
+
+func (suite *KeeperTestSuite) TestDividend() {
+	msg := types.NewMsgIssueToken("btc", "satoshi", "Bitcoin Network", 0, sdk.NewInt(1000), sdk.NewInt(2000), true, owner)
+
+	err := suite.keeper.IssueToken(suite.ctx, *msg)
+	require.NoError(suite.T(), err)
+
+	holder1 := sdk.AccAddress([]byte("tokenHolder1"))
+	holder2 := sdk.AccAddress([]byte("tokenHolder2"))
+
+	// the bank keeper settles the dividends before the transfer
+	transfer := func(from, to sdk.AccAddress, amount int64) {
+		coins := sdk.NewCoins(sdk.NewCoin("satoshi", sdk.NewInt(amount)))
+		require.NoError(suite.T(), suite.bk.SendCoins(suite.ctx, from, to, coins))
+	}
+	dividends := func(addr sdk.AccAddress) sdk.Coins {
+		coins, err := suite.keeper.GetDividends(suite.ctx, "btc", addr)
+		require.NoError(suite.T(), err)
+		return coins
+	}
+
+	transfer(owner, holder1, 250)
+
+	// only the owner and the admins distribute the dividends, and not in the token itself
+	err = suite.keeper.DistributeDividend(suite.ctx, *types.NewMsgDistributeDividend("btc", holder1, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(1000)))))
+	suite.True(types.ErrInvalidOwner.Is(err))
+	err = suite.keeper.DistributeDividend(suite.ctx, *types.NewMsgDistributeDividend("btc", owner, sdk.NewCoins(sdk.NewCoin("satoshi", sdk.NewInt(100)))))
+	suite.Error(err)
+
+	err = suite.keeper.DistributeDividend(suite.ctx, *types.NewMsgDistributeDividend("btc", owner, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(1000)))))
+	require.NoError(suite.T(), err)
+
+	suite.Equal(sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(750))), dividends(owner))
+	suite.Equal(sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(250))), dividends(holder1))
+	suite.True(dividends(holder2).Empty())
+
+	// the dividends accrued before a transfer stay with the sender
+	transfer(holder1, holder2, 250)
+
+	admin := sdk.AccAddress([]byte("tokenAdmin"))
+	err = suite.keeper.GrantRole(suite.ctx, *types.NewMsgGrantRole("btc", owner, admin, types.RoleAdmin))
+	require.NoError(suite.T(), err)
+	err = suite.bk.SendCoins(suite.ctx, owner, admin, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(500))))
+	require.NoError(suite.T(), err)
+
+	err = suite.keeper.DistributeDividend(suite.ctx, *types.NewMsgDistributeDividend("btc", admin, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(500)))))
+	require.NoError(suite.T(), err)
+
+	suite.Equal(sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(1125))), dividends(owner))
+	suite.Equal(sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(250))), dividends(holder1))
+	suite.Equal(sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(125))), dividends(holder2))
+
+	claimed, err := suite.keeper.ClaimDividend(suite.ctx, *types.NewMsgClaimDividend("btc", holder1))
+	require.NoError(suite.T(), err)
+	suite.Equal(sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(250))), claimed)
+	suite.Equal(sdk.NewCoin(denom, sdk.NewInt(250)), suite.bk.GetBalance(suite.ctx, holder1, denom))
+
+	_, err = suite.keeper.ClaimDividend(suite.ctx, *types.NewMsgClaimDividend("btc", holder1))
+	suite.Error(err)
+	suite.Equal(sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(1250))), suite.keeper.GetDividendReserve(suite.ctx, "btc"))
+
+	// the unclaimed dividends are paid on retirement
+	transfer(holder2, owner, 250)
+	err = suite.keeper.BurnToken(suite.ctx, *types.NewMsgBurnToken("btc", owner, sdk.NewInt(1000)))
+	require.NoError(suite.T(), err)
+
+	beginAmt := suite.bk.GetBalance(suite.ctx, owner, denom).Amount
+	err = suite.keeper.RetireToken(suite.ctx, *types.NewMsgRetireToken("btc", owner))
+	require.NoError(suite.T(), err)
+
+	suite.Equal(beginAmt.Add(sdk.NewInt(1125)), suite.bk.GetBalance(suite.ctx, owner, denom).Amount)
+	suite.Equal(sdk.NewCoin(denom, sdk.NewInt(125)), suite.bk.GetBalance(suite.ctx, holder2, denom))
+	suite.Empty(suite.keeper.GetAllDividendIndexes(suite.ctx))
+	suite.Empty(suite.keeper.GetAllDividendCheckpoints(suite.ctx))
+
+	res, broken := keeper.AllInvariants(suite.keeper)(suite.ctx)
+	suite.False(broken, res)
+}
+
+func (suite *KeeperTestSuite) TestAuction() {
+	params := suite.keeper.GetParamSet(suite.ctx)
+	params.AuctionSymbolLength = 4
+	params.BidCommitPeriod = 10
+	params.BidRevealPeriod = 10
+	params.SymbolReservationPeriod = 100
+	suite.keeper.SetParamSet(suite.ctx, params)
+
+	bidder := sdk.AccAddress([]byte("tokenBidder"))
+	err := suite.bk.SendCoins(suite.ctx, owner, bidder, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(1000))))
+	suite.NoError(err)
+
+	ctx := suite.ctx.WithBlockHeight(1)
+	bid := func(amount int64) sdk.Coin { return sdk.NewCoin(denom, sdk.NewInt(amount)) }
+
+	// a short symbol can only be issued by the winner of its auction
+	msg := types.NewMsgIssueToken("btc", "satoshi", "Bitcoin Network", 0, sdk.NewInt(1000), sdk.NewInt(2000), true, owner)
+	suite.Error(suite.keeper.IssueToken(ctx, *msg))
+	err = suite.keeper.CommitBid(ctx, *types.NewMsgCommitBid("bitcoin", owner, types.BidHash("bitcoin", owner, bid(300), "salt"), bid(500)))
+	suite.Error(err)
+
+	// the deposit hides the bid of the owner
+	err = suite.keeper.CommitBid(ctx, *types.NewMsgCommitBid("btc", owner, types.BidHash("btc", owner, bid(300), "salt"), bid(500)))
+	require.NoError(suite.T(), err)
+	// the bid hash is compared in lowercase
+	uppercaseHash := strings.ToUpper(types.BidHash("btc", bidder, bid(200), "salt"))
+	err = suite.keeper.CommitBid(ctx, types.MsgCommitBid{Symbol: "btc", Bidder: bidder, BidHash: uppercaseHash, Deposit: bid(200)})
+	require.NoError(suite.T(), err)
+	suite.Len(suite.keeper.GetBids(ctx, "btc"), 2)
+
+	// the bids are revealed after the commit period
+	err = suite.keeper.RevealBid(ctx, *types.NewMsgRevealBid("btc", owner, bid(300), "salt"))
+	suite.Error(err)
+
+	ctx = ctx.WithBlockHeight(11)
+	err = suite.keeper.CommitBid(ctx, *types.NewMsgCommitBid("btc", bidder, types.BidHash("btc", bidder, bid(400), "salt"), bid(400)))
+	suite.Error(err)
+	err = suite.keeper.RevealBid(ctx, *types.NewMsgRevealBid("btc", owner, bid(300), "pepper"))
+	suite.Error(err)
+	err = suite.keeper.RevealBid(ctx, *types.NewMsgRevealBid("btc", owner, bid(300), "salt"))
+	require.NoError(suite.T(), err)
+	err = suite.keeper.RevealBid(ctx, *types.NewMsgRevealBid("btc", bidder, bid(200), "salt"))
+	require.NoError(suite.T(), err)
+
+	// the highest bid wins and the other deposits are refunded
+	ctx = ctx.WithBlockHeight(21)
+	auctions := suite.keeper.GetEndedAuctions(ctx, ctx.BlockHeight())
+	suite.Len(auctions, 1)
+
+	winner, err := suite.keeper.SettleAuction(ctx, auctions[0])
+	require.NoError(suite.T(), err)
+	suite.Equal(owner, winner.Bidder)
+	suite.Equal(bid(300), winner.Amount)
+	suite.Equal(initAmt.SubRaw(1300), suite.bk.GetBalance(ctx, owner, denom).Amount)
+	suite.Equal(sdk.NewInt(1000), suite.bk.GetBalance(ctx, bidder, denom).Amount)
+	suite.Empty(suite.keeper.GetAllAuctions(ctx))
+	suite.Empty(suite.keeper.GetAllBids(ctx))
+
+	reservation, err := suite.keeper.GetSymbolReservation(ctx, "btc")
+	require.NoError(suite.T(), err)
+	suite.Equal(int64(121), reservation.ExpireHeight)
+
+	// the winner issues the symbol exclusively
+	err = suite.keeper.IssueToken(ctx, *types.NewMsgIssueToken("btc", "satoshi", "Bitcoin Network", 0, sdk.NewInt(1000), sdk.NewInt(2000), true, bidder))
+	suite.Error(err)
+	err = suite.keeper.IssueToken(ctx, *msg)
+	require.NoError(suite.T(), err)
+	suite.Empty(suite.keeper.GetAllSymbolReservations(ctx))
+}
+
+func (suite *KeeperTestSuite) TestSymbolLease() {
+	// the tokens issued before the leases are enabled are registered forever
+	err := suite.keeper.IssueToken(suite.ctx, *types.NewMsgIssueToken("eth", "wei", "Ethereum Network", 18, sdk.NewInt(1000), sdk.NewInt(2000), true, owner))
+	require.NoError(suite.T(), err)
+	_, err = suite.keeper.RenewSymbol(suite.ctx, *types.NewMsgRenewSymbol("eth", owner))
+	suite.Error(err)
+
+	params := suite.keeper.GetParamSet(suite.ctx)
+	params.SymbolLeasePeriod = 100
+	suite.keeper.SetParamSet(suite.ctx, params)
+
+	_, err = suite.keeper.RenewSymbol(suite.ctx, *types.NewMsgRenewSymbol("eth", owner))
+	suite.Error(err)
+
+	ctx := suite.ctx.WithBlockHeight(1)
+	err = suite.keeper.IssueToken(ctx, *types.NewMsgIssueToken("atom", "uatom", "Cosmos Hub", 6, sdk.NewInt(1000), sdk.NewInt(2000), true, owner))
+	require.NoError(suite.T(), err)
+
+	admin := sdk.AccAddress([]byte("tokenAdmin"))
+	err = suite.keeper.GrantRole(ctx, *types.NewMsgGrantRole("atom", owner, admin, types.RoleAdmin))
+	require.NoError(suite.T(), err)
+	minter := sdk.AccAddress([]byte("tokenMinter"))
+	err = suite.keeper.GrantMinter(ctx, *types.NewMsgGrantMinter("atom", owner, minter, sdk.NewInt(100), nil))
+	require.NoError(suite.T(), err)
+
+	suite.Empty(suite.keeper.GetExpiringTokens(ctx, 100))
+	suite.Len(suite.keeper.GetExpiringTokens(ctx, 101), 1)
+
+	// an active lease is extended from its expiration
+	ctx = ctx.WithBlockHeight(50)
+	_, err = suite.keeper.RenewSymbol(ctx, *types.NewMsgRenewSymbol("atom", minter))
+	suite.True(types.ErrInvalidOwner.Is(err))
+	expireHeight, err := suite.keeper.RenewSymbol(ctx, *types.NewMsgRenewSymbol("atom", owner))
+	require.NoError(suite.T(), err)
+	suite.Equal(int64(201), expireHeight)
+	suite.Empty(suite.keeper.GetExpiringTokens(ctx, 200))
+
+	ctx = ctx.WithBlockHeight(201)
+	tokens := suite.keeper.GetExpiringTokens(ctx, ctx.BlockHeight())
+	suite.Len(tokens, 1)
+	require.NoError(suite.T(), suite.keeper.LapseToken(ctx, tokens[0]))
+	suite.Empty(suite.keeper.GetExpiringTokens(ctx, ctx.BlockHeight()))
+
+	// neither the owner, the granted roles nor the minters of a lapsed token hold any privilege
+	token, err := suite.keeper.GetToken(ctx, "atom")
+	require.NoError(suite.T(), err)
+	suite.True(token.GetLapsed())
+
+	err = suite.keeper.PauseToken(ctx, *types.NewMsgPauseToken("atom", owner))
+	suite.True(types.ErrTokenLapsed.Is(err))
+	err = suite.keeper.TransferTokenOwner(ctx, *types.NewMsgTransferTokenOwner(owner, admin, "atom"))
+	suite.True(types.ErrTokenLapsed.Is(err))
+	err = suite.keeper.PauseToken(ctx, *types.NewMsgPauseToken("atom", admin))
+	suite.True(types.ErrTokenLapsed.Is(err))
+	err = suite.keeper.MintToken(ctx, *types.NewMsgMintToken("atom", minter, minter, sdk.NewInt(10)))
+	suite.True(types.ErrTokenLapsed.Is(err))
+
+	// a lapsed lease restarts from the current height, and is renewed by the owner or the admins
+	ctx = ctx.WithBlockHeight(250)
+	_, err = suite.keeper.RenewSymbol(ctx, *types.NewMsgRenewSymbol("atom", minter))
+	suite.True(types.ErrInvalidOwner.Is(err))
+	expireHeight, err = suite.keeper.RenewSymbol(ctx, *types.NewMsgRenewSymbol("atom", admin))
+	require.NoError(suite.T(), err)
+	suite.Equal(int64(350), expireHeight)
+
+	// the granted roles and minters are kept through the lapse
+	err = suite.keeper.PauseToken(ctx, *types.NewMsgPauseToken("atom", admin))
+	require.NoError(suite.T(), err)
+	err = suite.keeper.UnpauseToken(ctx, *types.NewMsgUnpauseToken("atom", owner))
+	require.NoError(suite.T(), err)
+	err = suite.keeper.MintToken(ctx, *types.NewMsgMintToken("atom", minter, minter, sdk.NewInt(10)))
+	require.NoError(suite.T(), err)
+	suite.Len(suite.keeper.GetExpiringTokens(ctx, 350), 1)
+}
+
+func (suite *KeeperTestSuite) TestInvariants() {
+	investor := sdk.AccAddress([]byte("tokenInvestor"))
+	msg := types.NewMsgIssueToken("btc", "satoshi", "Bitcoin Network", 0, sdk.NewInt(1000), sdk.NewInt(2000), true, owner)
+	msg.Allocations = []types.VestingAllocation{{
+		Recipient: investor,
+		Amount:    sdk.NewInt(400),
+		EndTime:   suite.ctx.BlockTime().Add(100 * time.Hour),
+	}}
+
+	err := suite.keeper.IssueToken(suite.ctx, *msg)
+	require.NoError(suite.T(), err)
+
+	params := suite.keeper.GetParamSet(suite.ctx)
+	params.AuctionSymbolLength = 4
+	suite.keeper.SetParamSet(suite.ctx, params)
+
+	deposit := sdk.NewCoin(denom, sdk.NewInt(100))
+	err = suite.keeper.CommitBid(suite.ctx, *types.NewMsgCommitBid("eth", owner, types.BidHash("eth", owner, deposit, "salt"), deposit))
+	require.NoError(suite.T(), err)
+
+	// the unvested supply and the bid deposit are held by the module account
+	_, broken := keeper.AllInvariants(suite.keeper)(suite.ctx)
+	suite.False(broken)
+
+	store := suite.ctx.KVStore(suite.app.GetKey(types.StoreKey))
+	symbol := suite.app.AppCodec().MustMarshalBinaryBare(&gogotypes.StringValue{Value: "btc"})
+
+	// a missing index entry
+	store.Delete(types.KeyMinUint("satoshi"))
+	_, broken = keeper.TokenIndexesInvariant(suite.keeper)(suite.ctx)
+	suite.True(broken)
+	store.Set(types.KeyMinUint("satoshi"), symbol)
+
+	// a duplicated index entry
+	store.Set(types.KeyTokens(investor, "btc"), symbol)
+	_, broken = keeper.TokenIndexesInvariant(suite.keeper)(suite.ctx)
+	suite.True(broken)
+	_, broken = keeper.OrphanedIndexesInvariant(suite.keeper)(suite.ctx)
+	suite.True(broken)
+	store.Delete(types.KeyTokens(investor, "btc"))
+
+	// an index entry of a missing token
+	store.Set(types.KeyMinUint("wei"), suite.app.AppCodec().MustMarshalBinaryBare(&gogotypes.StringValue{Value: "eth"}))
+	_, broken = keeper.OrphanedIndexesInvariant(suite.keeper)(suite.ctx)
+	suite.True(broken)
+	store.Delete(types.KeyMinUint("wei"))
+
+	// a holder count out of sync with the holder index
+	count := store.Get(types.KeyHolderCount("satoshi"))
+	store.Delete(types.KeyHolderCount("satoshi"))
+	_, broken = keeper.HolderIndexInvariant(suite.keeper)(suite.ctx)
+	suite.True(broken)
+	store.Set(types.KeyHolderCount("satoshi"), count)
+
+	_, broken = keeper.AllInvariants(suite.keeper)(suite.ctx)
+	suite.False(broken)
+
+	// the supply minted beyond the max supply
+	coins := sdk.NewCoins(sdk.NewCoin("satoshi", sdk.NewInt(1001)))
+	require.NoError(suite.T(), suite.bk.MintCoins(suite.ctx, types.ModuleName, coins))
+	_, broken = keeper.MaxSupplyInvariant(suite.keeper)(suite.ctx)
+	suite.True(broken)
+
+	// the coins left over in the module account
+	_, broken = keeper.ModuleAccountInvariant(suite.keeper)(suite.ctx)
+	suite.True(broken)
+}
+
+func (suite *KeeperTestSuite) TestHolders() {
+	holder1 := sdk.AccAddress([]byte("tokenHolder1"))
+	holder2 := sdk.AccAddress([]byte("tokenHolder2"))
+
+	msg := types.NewMsgIssueToken("btc", "satoshi", "Bitcoin Network", 0, sdk.NewInt(1000), sdk.NewInt(2000), true, owner)
+	err := suite.keeper.IssueToken(suite.ctx, *msg)
+	require.NoError(suite.T(), err)
+
+	err = suite.bk.SendCoins(suite.ctx, owner, holder1, sdk.NewCoins(sdk.NewCoin("satoshi", sdk.NewInt(300))))
+	require.NoError(suite.T(), err)
+	err = suite.bk.SendCoins(suite.ctx, owner, holder2, sdk.NewCoins(sdk.NewCoin("satoshi", sdk.NewInt(500))))
+	require.NoError(suite.T(), err)
+
+	count, err := suite.keeper.GetHolderCount(suite.ctx, "btc")
+	require.NoError(suite.T(), err)
+	suite.Equal(uint64(3), count)
+
+	// the holders are ordered by balance from the largest
+	holders, pageRes, err := suite.keeper.GetPaginatedHolders(suite.ctx, "satoshi", &query.PageRequest{Limit: 2})
+	require.NoError(suite.T(), err)
+	suite.Equal([]types.Holder{
+		{Address: holder2, Balance: sdk.NewCoin("satoshi", sdk.NewInt(500))},
+		{Address: holder1, Balance: sdk.NewCoin("satoshi", sdk.NewInt(300))},
+	}, holders)
+
+	holders, _, err = suite.keeper.GetPaginatedHolders(suite.ctx, "btc", &query.PageRequest{Key: pageRes.NextKey})
+	require.NoError(suite.T(), err)
+	suite.Equal([]types.Holder{{Address: owner, Balance: sdk.NewCoin("satoshi", sdk.NewInt(200))}}, holders)
+
+	// the holders whose balances drop to zero are removed
+	err = suite.bk.SendCoins(suite.ctx, holder1, holder2, sdk.NewCoins(sdk.NewCoin("satoshi", sdk.NewInt(300))))
+	require.NoError(suite.T(), err)
+	err = suite.keeper.BurnToken(suite.ctx, *types.NewMsgBurnToken("btc", owner, sdk.NewInt(200)))
+	require.NoError(suite.T(), err)
+
+	_, found := suite.keeper.GetHolder(suite.ctx, "satoshi", holder1)
+	suite.False(found)
+
+	holders, _, err = suite.keeper.GetPaginatedHolders(suite.ctx, "btc", nil)
+	require.NoError(suite.T(), err)
+	suite.Equal([]types.Holder{{Address: holder2, Balance: sdk.NewCoin("satoshi", sdk.NewInt(800))}}, holders)
+
+	_, broken := keeper.AllInvariants(suite.keeper)(suite.ctx)
+	suite.False(broken)
+
+	_, err = suite.keeper.GetHolderCount(suite.ctx, "eth")
+	suite.Error(err)
+
+	// the holders of the native token are not indexed
+	err = suite.bk.SendCoins(suite.ctx, owner, holder1, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(100))))
+	require.NoError(suite.T(), err)
+
+	_, found = suite.keeper.GetHolder(suite.ctx, denom, holder1)
+	suite.False(found)
+
+	_, err = suite.keeper.GetHolderCount(suite.ctx, denom)
+	suite.True(types.ErrHoldersNotIndexed.Is(err))
+	_, _, err = suite.keeper.GetPaginatedHolders(suite.ctx, denom, nil)
+	suite.True(types.ErrHoldersNotIndexed.Is(err))
+}
+
+func (suite *KeeperTestSuite) TestTokenHistory() {
+	dstOwner := sdk.AccAddress([]byte("TokenDstOwner"))
+
+	msg := types.NewMsgIssueToken("btc", "satoshi", "Bitcoin Network", 18, sdk.NewInt(1000), sdk.NewInt(2000), true, owner)
+	err := suite.keeper.IssueToken(suite.ctx, *msg)
+	require.NoError(suite.T(), err)
+
+	err = suite.keeper.EditToken(suite.ctx, *types.NewMsgEditToken("Bitcoin Token", "btc", sdk.NewInt(0), types.False, owner))
+	require.NoError(suite.T(), err)
+
+	// the edit which changes nothing is not recorded
+	err = suite.keeper.EditToken(suite.ctx, *types.NewMsgEditToken(types.DoNotModify, "btc", sdk.NewInt(0), types.Nil, owner))
+	require.NoError(suite.T(), err)
+
+	err = suite.keeper.TransferTokenOwner(suite.ctx, *types.NewMsgTransferTokenOwner(owner, dstOwner, "btc"))
+	require.NoError(suite.T(), err)
+	err = suite.keeper.AcceptTokenOwner(suite.ctx, *types.NewMsgAcceptTokenOwner("btc", dstOwner))
+	require.NoError(suite.T(), err)
+
+	err = suite.keeper.PauseToken(suite.ctx, *types.NewMsgPauseToken("btc", dstOwner))
+	require.NoError(suite.T(), err)
+
+	entries, pageRes, err := suite.keeper.GetTokenHistory(suite.ctx, "btc", &query.PageRequest{Limit: 2})
+	require.NoError(suite.T(), err)
+	suite.Len(entries, 2)
+
+	suite.Equal(uint64(1), entries[0].Sequence)
+	suite.Equal(owner, entries[0].Signer)
+	suite.Equal(types.TypeMsgEditToken, entries[0].Action)
+	suite.Equal([]types.TokenChange{
+		{Field: "name", OldValue: "Bitcoin Network", NewValue: "Bitcoin Token"},
+		{Field: "mintable", OldValue: "true", NewValue: "false"},
+	}, entries[0].Changes)
+
+	suite.Equal(uint64(2), entries[1].Sequence)
+	suite.Equal(dstOwner, entries[1].Signer)
+	suite.Equal(types.TypeMsgAcceptTokenOwner, entries[1].Action)
+	suite.Equal([]types.TokenChange{{Field: "owner", OldValue: owner.String(), NewValue: dstOwner.String()}}, entries[1].Changes)
+
+	entries, _, err = suite.keeper.GetTokenHistory(suite.ctx, "btc", &query.PageRequest{Key: pageRes.NextKey})
+	require.NoError(suite.T(), err)
+	suite.Len(entries, 1)
+	suite.Equal(uint64(3), entries[0].Sequence)
+	suite.Equal([]types.TokenChange{{Field: "paused", OldValue: "false", NewValue: "true"}}, entries[0].Changes)
+
+	suite.Equal(uint64(3), suite.keeper.GetLatestTokenHistorySequence(suite.ctx, "btc"))
+	suite.Len(suite.keeper.GetAllTokenHistory(suite.ctx), 3)
+
+	// the history of the retired token is kept under the retirement
+	ctx := suite.ctx.WithBlockHeight(10)
+	err = suite.keeper.UnpauseToken(ctx, *types.NewMsgUnpauseToken("btc", dstOwner))
+	require.NoError(suite.T(), err)
+	err = suite.keeper.BurnToken(ctx, *types.NewMsgBurnToken("btc", owner, sdk.NewInt(1000)))
+	require.NoError(suite.T(), err)
+	err = suite.keeper.RetireToken(ctx, *types.NewMsgRetireToken("btc", dstOwner))
+	require.NoError(suite.T(), err)
+
+	suite.Zero(suite.keeper.GetLatestTokenHistorySequence(ctx, "btc"))
+	entries, _, err = suite.keeper.GetRetiredTokenHistory(ctx, "btc", 10, nil)
+	require.NoError(suite.T(), err)
+	suite.Len(entries, 4)
+	for _, entry := range entries {
+		suite.Equal(int64(10), entry.RetireHeight)
+	}
+
+	// the symbol registered again starts a new history
+	ctx = ctx.WithBlockHeight(10 + int64(suite.keeper.GetParamSet(ctx).RetiredSymbolCooldown))
+	err = suite.keeper.IssueToken(ctx, *msg)
+	require.NoError(suite.T(), err)
+	err = suite.keeper.PauseToken(ctx, *types.NewMsgPauseToken("btc", owner))
+	require.NoError(suite.T(), err)
+
+	entries, _, err = suite.keeper.GetTokenHistory(ctx, "btc", nil)
+	require.NoError(suite.T(), err)
+	suite.Len(entries, 1)
+	suite.Equal(uint64(1), entries[0].Sequence)
+	suite.Zero(entries[0].RetireHeight)
+	suite.Len(suite.keeper.GetAllTokenHistory(ctx), 5)
+}
+
+func (suite *KeeperTestSuite) TestTokenHooks() {
+	hooks1, hooks2 := &recordingHooks{}, &recordingHooks{}
+	suite.keeper.SetHooks(types.NewMultiTokenHooks(hooks1, hooks2))
+	suite.Panics(func() { suite.keeper.SetHooks(&recordingHooks{}) })
+
+	dstOwner := sdk.AccAddress([]byte("TokenDstOwner"))
+
+	msg := types.NewMsgIssueToken("btc", "satoshi", "Bitcoin Network", 0, sdk.NewInt(1000), sdk.NewInt(2000), true, owner)
+	err := suite.keeper.IssueToken(suite.ctx, *msg)
+	require.NoError(suite.T(), err)
+
+	err = suite.keeper.MultiMint(suite.ctx, *types.NewMsgMultiMint("btc", owner, []types.MintRecipient{
+		{Amount: sdk.NewInt(100)},
+		{Address: dstOwner, Amount: sdk.NewInt(200)},
+	}))
+	require.NoError(suite.T(), err)
+
+	err = suite.keeper.EditToken(suite.ctx, *types.NewMsgEditToken("Bitcoin Token", "btc", sdk.NewInt(0), types.Nil, owner))
+	require.NoError(suite.T(), err)
+
+	// the edit which changes nothing does not call the hook
+	err = suite.keeper.EditToken(suite.ctx, *types.NewMsgEditToken(types.DoNotModify, "btc", sdk.NewInt(0), types.Nil, owner))
+	require.NoError(suite.T(), err)
+
+	// the owner changes only after the transfer is accepted
+	err = suite.keeper.TransferTokenOwner(suite.ctx, *types.NewMsgTransferTokenOwner(owner, dstOwner, "btc"))
+	require.NoError(suite.T(), err)
+	err = suite.keeper.AcceptTokenOwner(suite.ctx, *types.NewMsgAcceptTokenOwner("btc", dstOwner))
+	require.NoError(suite.T(), err)
+
+	expCalls := []string{
+		"issued btc " + owner.String(),
+		"minted btc " + owner.String() + " 100satoshi",
+		"minted btc " + dstOwner.String() + " 200satoshi",
+		"edited btc name",
+		"owner_changed btc " + owner.String() + " " + dstOwner.String(),
+	}
+	suite.Equal(expCalls, hooks1.calls)
+	suite.Equal(expCalls, hooks2.calls)
+
+	// the hook error aborts the message
+	hooks1.err = errors.New("hook failed")
+	err = suite.keeper.MintToken(suite.ctx, *types.NewMsgMintToken("btc", dstOwner, nil, sdk.NewInt(100)))
+	suite.Error(err)
+	suite.Len(hooks2.calls, len(expCalls))
+}
+
+// recordingHooks records the calls of the token hooks and fails them with err if set
+type recordingHooks struct {
+	calls []string
+	err   error
+}
+
+func (h *recordingHooks) AfterTokenIssued(_ sdk.Context, token types.Token) error {
+	h.calls = append(h.calls, fmt.Sprintf("issued %s %s", token.Symbol, token.Owner))
+	return h.err
+}
+
+func (h *recordingHooks) AfterTokenMinted(_ sdk.Context, symbol string, recipient sdk.AccAddress, amount sdk.Coin) error {
+	h.calls = append(h.calls, fmt.Sprintf("minted %s %s %s", symbol, recipient, amount))
+	return h.err
+}
+
+func (h *recordingHooks) AfterTokenEdited(_ sdk.Context, token types.Token, changes []types.TokenChange) error {
+	fields := make([]string, len(changes))
+	for i, change := range changes {
+		fields[i] = change.Field
+	}
+	h.calls = append(h.calls, fmt.Sprintf("edited %s %s", token.Symbol, strings.Join(fields, ",")))
+	return h.err
+}
+
+func (h *recordingHooks) AfterTokenOwnerChanged(_ sdk.Context, symbol string, srcOwner, dstOwner sdk.AccAddress) error {
+	h.calls = append(h.calls, fmt.Sprintf("owner_changed %s %s %s", symbol, srcOwner, dstOwner))
+	return h.err
+}
+
+func (suite *KeeperTestSuite) TestMigrateLegacyTokens() {
+	legacyToken := v1.Token{
+		Symbol:        "btc",
+		Name:          "Bitcoin Network",
+		Scale:         8,
+		MinUnit:       "satoshi",
+		InitialSupply: 21000000,
+		MaxSupply:     math.MaxUint64,
+		Mintable:      true,
+		Owner:         owner,
+	}
+
+	// the store holds the tokens in the legacy layout before the migration
+	store := suite.ctx.KVStore(suite.app.GetKey(types.StoreKey))
+	for _, tokenI := range suite.keeper.GetTokens(suite.ctx, nil) {
+		store.Set(types.KeySymbol(tokenI.GetSymbol()), suite.app.AppCodec().MustMarshalBinaryBare(&v1.Token{
+			Symbol:        tokenI.GetSymbol(),
+			Name:          tokenI.GetName(),
+			Scale:         tokenI.GetScale(),
+			MinUnit:       tokenI.GetMinUnit(),
+			InitialSupply: tokenI.GetInitialSupply().Uint64(),
+			MaxSupply:     tokenI.GetMaxSupply().Uint64(),
+			Mintable:      tokenI.GetMintable(),
+			Owner:         tokenI.GetOwner(),
+		}))
+	}
+	store.Set(types.KeySymbol(legacyToken.Symbol), suite.app.AppCodec().MustMarshalBinaryBare(&legacyToken))
+
+	err := v1.MigrateStore(suite.ctx, suite.app.GetKey(types.StoreKey), suite.app.AppCodec())
+	require.NoError(suite.T(), err)
+
+	token, err := suite.keeper.GetToken(suite.ctx, "btc")
+	require.NoError(suite.T(), err)
+	suite.Equal(sdk.NewInt(21000000), token.GetInitialSupply())
+	suite.Equal(sdk.NewIntFromUint64(math.MaxUint64), token.GetMaxSupply())
+
+	// the token is indexed by the min_unit
+	_, err = suite.keeper.GetToken(suite.ctx, legacyToken.MinUnit)
+	require.NoError(suite.T(), err)
+
+	nativeToken, err := suite.keeper.GetToken(suite.ctx, denom)
+	require.NoError(suite.T(), err)
+	suite.Equal(types.GetNativeToken().MaxSupply, nativeToken.GetMaxSupply())
+}
+
+func (suite *KeeperTestSuite) TestRegisterMigration() {
+	migrator := keeper.NewMigrator(suite.keeper)
+	handler := func(ctx sdk.Context) error { return nil }
+
+	// the migrations of all the version steps are registered
+	suite.Error(migrator.RegisterMigration(types.ConsensusVersion-1, handler))
+
+	suite.Error(migrator.RegisterMigration(0, handler))
+	suite.Error(migrator.RegisterMigration(types.ConsensusVersion, handler))
 }
@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	gogotypes "github.com/gogo/protobuf/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/irismod/token/types"
+)
+
+// GetExpiringTokens returns the tokens whose symbol leases expire at or before the specified height
+func (k Keeper) GetExpiringTokens(ctx sdk.Context, height int64) (tokens []types.Token) {
+	store := ctx.KVStore(k.storeKey)
+
+	it := store.Iterator(types.PrefixLeaseQueue, sdk.PrefixEndBytes(types.KeyLeaseQueueByHeight(height)))
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		var symbol gogotypes.StringValue
+		k.cdc.MustUnmarshalBinaryBare(it.Value(), &symbol)
+
+		token, err := k.getToken(ctx, symbol.Value)
+		if err != nil {
+			continue
+		}
+		tokens = append(tokens, token)
+	}
+	return
+}
+
+// RenewSymbol extends the symbol lease of the token by a lease period, which restores
+// the privileges of the owner and the granted roles of a lapsed token
+func (k Keeper) RenewSymbol(ctx sdk.Context, msg types.MsgRenewSymbol) (int64, error) {
+	tokenI, err := k.GetToken(ctx, msg.Symbol)
+	if err != nil {
+		return 0, err
+	}
+
+	token := tokenI.(*types.Token)
+
+	// the renewal is the only privilege kept by the owner and the admins of a lapsed token
+	if err := k.checkGrantedRole(ctx, *token, msg.Owner, types.RoleAdmin); err != nil {
+		return 0, err
+	}
+
+	period := k.GetParamSet(ctx).SymbolLeasePeriod
+	if period == 0 {
+		return 0, sdkerrors.Wrap(types.ErrInvalidLease, "the symbol leases are disabled")
+	}
+
+	// the tokens issued before the leases are enabled are registered forever
+	if token.LeaseExpireHeight == 0 {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidLease, "the token %s has no symbol lease to renew", msg.Symbol)
+	}
+
+	// an active lease is extended from its expiration, while a lapsed one restarts from the current height
+	expireHeight := token.LeaseExpireHeight
+	if token.Lapsed {
+		expireHeight = ctx.BlockHeight()
+	}
+
+	k.removeLeaseQueue(ctx, *token)
+
+	token.LeaseExpireHeight = expireHeight + int64(period)
+	token.Lapsed = false
+	if err := k.updateToken(ctx, *token, msg.Owner, msg.Type()); err != nil {
+		return 0, err
+	}
+
+	k.setLeaseQueue(ctx, *token)
+	return token.LeaseExpireHeight, nil
+}
+
+// LapseToken marks the token whose symbol lease has expired as lapsed, which revokes the owner privileges
+func (k Keeper) LapseToken(ctx sdk.Context, token types.Token) error {
+	k.removeLeaseQueue(ctx, token)
+
+	token.Lapsed = true
+	return k.updateToken(ctx, token, nil, types.EventTypeLapseToken)
+}
+
+// setLeaseQueue indexes the active symbol lease of the token by the expiration height
+func (k Keeper) setLeaseQueue(ctx sdk.Context, token types.Token) {
+	if token.LeaseExpireHeight == 0 || token.Lapsed {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshalBinaryBare(&gogotypes.StringValue{Value: token.Symbol})
+	store.Set(types.KeyLeaseQueue(token.LeaseExpireHeight, token.Symbol), bz)
+}
+
+func (k Keeper) removeLeaseQueue(ctx sdk.Context, token types.Token) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyLeaseQueue(token.LeaseExpireHeight, token.Symbol))
+}
+
+// checkLease returns an error if the symbol lease of the token has lapsed
+func checkLease(token types.Token) error {
+	if token.Lapsed {
+		return sdkerrors.Wrapf(types.ErrTokenLapsed, "the symbol lease of the token %s expired at height %d", token.Symbol, token.LeaseExpireHeight)
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	"fmt"
+
+	gogotypes "github.com/gogo/protobuf/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	v1 "github.com/irismod/token/legacy/v1"
+	"github.com/irismod/token/types"
+)
+
+// MigrationHandler migrates the store of the token module from a version to the next one
+type MigrationHandler func(ctx sdk.Context) error
+
+// Migrator migrates the store of the token module in place
+type Migrator struct {
+	keeper   Keeper
+	handlers map[uint64]MigrationHandler
+}
+
+// NewMigrator returns a new Migrator with the migrations of all the version steps registered
+func NewMigrator(keeper Keeper) Migrator {
+	m := Migrator{
+		keeper:   keeper,
+		handlers: make(map[uint64]MigrationHandler),
+	}
+
+	if err := m.RegisterMigration(1, m.Migrate1to2); err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// RegisterMigration registers the handler which migrates the store from the specified version to the next one
+func (m Migrator) RegisterMigration(fromVersion uint64, handler MigrationHandler) error {
+	if fromVersion == 0 || fromVersion >= types.ConsensusVersion {
+		return sdkerrors.Wrapf(types.ErrInvalidStoreVersion, "the migration must start from a version in [1, %d)", types.ConsensusVersion)
+	}
+
+	if _, ok := m.handlers[fromVersion]; ok {
+		return sdkerrors.Wrapf(types.ErrInvalidStoreVersion, "the migration from version %d has been registered", fromVersion)
+	}
+
+	m.handlers[fromVersion] = handler
+	return nil
+}
+
+// RunMigrations migrates the store step by step from its recorded version to the consensus version
+func (m Migrator) RunMigrations(ctx sdk.Context) error {
+	version := m.keeper.GetStoreVersion(ctx)
+	if version > types.ConsensusVersion {
+		return sdkerrors.Wrapf(types.ErrInvalidStoreVersion, "the store version %d is newer than the consensus version %d", version, types.ConsensusVersion)
+	}
+
+	for ; version < types.ConsensusVersion; version++ {
+		handler, ok := m.handlers[version]
+		if !ok {
+			return sdkerrors.Wrapf(types.ErrInvalidStoreVersion, "no migration from version %d is registered", version)
+		}
+
+		if err := handler(ctx); err != nil {
+			return sdkerrors.Wrap(err, fmt.Sprintf("failed to migrate the store from version %d", version))
+		}
+
+		m.keeper.SetStoreVersion(ctx, version+1)
+		m.keeper.Logger(ctx).Info(fmt.Sprintf("migrated the store to version %d", version+1))
+	}
+	return nil
+}
+
+// Migrate1to2 migrates the store created before the versioning: the supplies of the tokens are converted
+// from uint64 to sdk.Int, the token indexes are rebuilt, the holder indexes are built from the bank balances
+// and the params added since are set to their defaults
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	if err := v1.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc); err != nil {
+		return err
+	}
+
+	m.keeper.RebuildHolderIndexes(ctx)
+	m.migrateParams(ctx)
+	return nil
+}
+
+// migrateParams sets the params missing in the store to their defaults, keeping the stored ones
+func (m Migrator) migrateParams(ctx sdk.Context) {
+	params := types.DefaultParams()
+	for _, pair := range params.ParamSetPairs() {
+		if m.keeper.paramSpace.Has(ctx, pair.Key) {
+			m.keeper.paramSpace.Get(ctx, pair.Key, pair.Value)
+		}
+	}
+	m.keeper.SetParamSet(ctx, params)
+}
+
+// GetStoreVersion returns the version of the store layout. The store created before the versioning is of version 1
+func (k Keeper) GetStoreVersion(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.KeyStoreVersion)
+	if bz == nil {
+		return 1
+	}
+
+	var version gogotypes.UInt64Value
+	k.cdc.MustUnmarshalBinaryBare(bz, &version)
+	return version.Value
+}
+
+// SetStoreVersion saves the version of the store layout
+func (k Keeper) SetStoreVersion(ctx sdk.Context, version uint64) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshalBinaryBare(&gogotypes.UInt64Value{Value: version})
+	store.Set(types.KeyStoreVersion, bz)
+}
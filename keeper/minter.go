@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/irismod/token/types"
+)
+
+// GetMinter returns the minter of the specified symbol and address
+func (k Keeper) GetMinter(ctx sdk.Context, symbol string, addr sdk.AccAddress) (minter types.Minter, err error) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.KeyMinter(symbol, addr))
+	if bz == nil {
+		return minter, sdkerrors.Wrap(types.ErrMinterNotExists, fmt.Sprintf("minter %s of the token %s does not exist", addr, symbol))
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &minter)
+	return minter, nil
+}
+
+// GetMinters returns all the minters of the specified symbol
+func (k Keeper) GetMinters(ctx sdk.Context, symbol string) (minters []types.Minter) {
+	store := ctx.KVStore(k.storeKey)
+
+	it := sdk.KVStorePrefixIterator(store, types.KeyMinters(symbol))
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		var minter types.Minter
+		k.cdc.MustUnmarshalBinaryBare(it.Value(), &minter)
+
+		minters = append(minters, minter)
+	}
+	return
+}
+
+// GetAllMinters returns the minters of all the tokens
+func (k Keeper) GetAllMinters(ctx sdk.Context) (minters []types.Minter) {
+	store := ctx.KVStore(k.storeKey)
+
+	it := sdk.KVStorePrefixIterator(store, types.PrefixMinters)
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		var minter types.Minter
+		k.cdc.MustUnmarshalBinaryBare(it.Value(), &minter)
+
+		minters = append(minters, minter)
+	}
+	return
+}
+
+// SetMinter saves the minter
+func (k Keeper) SetMinter(ctx sdk.Context, minter types.Minter) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&minter)
+
+	store.Set(types.KeyMinter(minter.Symbol, minter.Address), bz)
+}
+
+func (k Keeper) deleteMinter(ctx sdk.Context, symbol string, addr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyMinter(symbol, addr))
+}
+
+// getMintableQuota returns the minter which is allowed to mint the specified amount of the token.
+// The minters of a lapsed token can not mint until the symbol lease is renewed
+func (k Keeper) getMintableQuota(ctx sdk.Context, token types.Token, addr sdk.AccAddress, amount sdk.Int) (types.Minter, error) {
+	if err := checkLease(token); err != nil {
+		return types.Minter{}, err
+	}
+
+	minter, err := k.GetMinter(ctx, token.Symbol, addr)
+	if err != nil {
+		return minter, sdkerrors.Wrapf(types.ErrInvalidOwner, "the address %s is neither the owner nor a minter of the token %s", addr, token.Symbol)
+	}
+
+	if minter.Expiry != nil && !ctx.BlockTime().Before(*minter.Expiry) {
+		return minter, sdkerrors.Wrapf(types.ErrMinterExpired, "the minter %s of the token %s expired at %s", addr, token.Symbol, minter.Expiry)
+	}
+
+	if amount.GT(minter.Quota) {
+		return minter, sdkerrors.Wrapf(types.ErrInvalidAmount, "the amount %s exceeds the remaining quota %s of the minter %s", amount, minter.Quota, addr)
+	}
+
+	return minter, nil
+}
+
+// consumeMinterQuota deducts the minted amount from the quota of the minter
+func (k Keeper) consumeMinterQuota(ctx sdk.Context, minter types.Minter, amount sdk.Int) {
+	minter.Quota = minter.Quota.Sub(amount)
+	if minter.Quota.IsZero() {
+		k.deleteMinter(ctx, minter.Symbol, minter.Address)
+		return
+	}
+	k.SetMinter(ctx, minter)
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"math"
 	"strings"
 
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -8,6 +9,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/irismod/token/types"
 )
@@ -19,6 +21,12 @@ func NewQuerier(k Keeper, legacyQuerierCdc *codec.LegacyAmino) sdk.Querier {
 			return queryToken(ctx, req, k, legacyQuerierCdc)
 		case types.QueryTokens:
 			return queryTokens(ctx, req, k, legacyQuerierCdc)
+		case types.QuerySupply:
+			return querySupply(ctx, req, k, legacyQuerierCdc)
+		case types.QueryHolders:
+			return queryHolders(ctx, req, k, legacyQuerierCdc)
+		case types.QueryHolderCount:
+			return queryHolderCount(ctx, req, k, legacyQuerierCdc)
 		case types.QueryFees:
 			return queryFees(ctx, req, k, legacyQuerierCdc)
 		case types.QueryParams:
@@ -54,8 +62,63 @@ func queryTokens(ctx sdk.Context, req abci.RequestQuery, keeper Keeper, legacyQu
 	if err := legacyQuerierCdc.UnmarshalJSON(req.Data, &params); err != nil {
 		return nil, err
 	}
-	tokens := keeper.GetTokens(ctx, params.Owner)
-	return codec.MarshalJSONIndent(legacyQuerierCdc, tokens)
+
+	if _, err := types.ParseBool(params.Mintable.String()); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	// all the tokens are returned without a page request, as before the pagination
+	if params.Pagination == nil {
+		params.Pagination = &query.PageRequest{Limit: math.MaxInt64}
+	}
+
+	tokens, pageRes, err := keeper.GetPaginatedTokens(ctx, params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return codec.MarshalJSONIndent(legacyQuerierCdc, types.QueryTokensResult{Tokens: tokens, Pagination: pageRes})
+}
+
+func querySupply(ctx sdk.Context, req abci.RequestQuery, keeper Keeper, legacyQuerierCdc *codec.LegacyAmino) ([]byte, error) {
+	var params types.QuerySupplyParams
+	if err := legacyQuerierCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, err
+	}
+
+	supply, mainSupply, err := keeper.GetSupply(ctx, strings.ToLower(params.Denom))
+	if err != nil {
+		return nil, err
+	}
+
+	return codec.MarshalJSONIndent(legacyQuerierCdc, types.QuerySupplyResponse{Supply: supply, MainSupply: mainSupply})
+}
+
+func queryHolders(ctx sdk.Context, req abci.RequestQuery, keeper Keeper, legacyQuerierCdc *codec.LegacyAmino) ([]byte, error) {
+	var params types.QueryHoldersParams
+	if err := legacyQuerierCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, err
+	}
+
+	holders, _, err := keeper.GetPaginatedHolders(ctx, strings.ToLower(params.Denom), params.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return codec.MarshalJSONIndent(legacyQuerierCdc, holders)
+}
+
+func queryHolderCount(ctx sdk.Context, req abci.RequestQuery, keeper Keeper, legacyQuerierCdc *codec.LegacyAmino) ([]byte, error) {
+	var params types.QueryHolderCountParams
+	if err := legacyQuerierCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, err
+	}
+
+	count, err := keeper.GetHolderCount(ctx, strings.ToLower(params.Denom))
+	if err != nil {
+		return nil, err
+	}
+
+	return codec.MarshalJSONIndent(legacyQuerierCdc, types.QueryHolderCountResponse{Count: count})
 }
 
 func queryFees(ctx sdk.Context, req abci.RequestQuery, keeper Keeper, legacyQuerierCdc *codec.LegacyAmino) ([]byte, error) {
@@ -70,8 +133,14 @@ func queryFees(ctx sdk.Context, req abci.RequestQuery, keeper Keeper, legacyQuer
 	}
 
 	symbol := strings.ToLower(params.Symbol)
-	issueFee := keeper.GetTokenIssueFee(ctx, symbol)
-	mintFee := keeper.GetTokenMintFee(ctx, symbol)
+	issueFee, err := keeper.ConvertFee(ctx, keeper.GetTokenIssueFee(ctx, symbol), params.FeeDenom)
+	if err != nil {
+		return nil, err
+	}
+	mintFee, err := keeper.ConvertFee(ctx, keeper.GetTokenMintFee(ctx, symbol), params.FeeDenom)
+	if err != nil {
+		return nil, err
+	}
 
 	fees := types.QueryFeesResponse{
 		Exist:    keeper.HasToken(ctx, symbol),
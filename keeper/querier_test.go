@@ -6,6 +6,7 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/irismod/token/keeper"
 	"github.com/irismod/token/types"
@@ -56,16 +57,42 @@ func (suite *KeeperTestSuite) TestQueryTokens() {
 		Owner: nil,
 	}
 	bz := suite.legacyAmino.MustMarshalJSON(params)
-	query := abci.RequestQuery{
+	req := abci.RequestQuery{
 		Path: fmt.Sprintf("/custom/%s/%s", types.QuerierRoute, types.QueryTokens),
 		Data: bz,
 	}
 
-	data, err := querier(ctx, []string{types.QueryTokens}, query)
+	data, err := querier(ctx, []string{types.QueryTokens}, req)
+	suite.Nil(err)
+
+	// all the tokens are returned without a page request
+	data2 := codec.MustMarshalJSONIndent(suite.legacyAmino, types.QueryTokensResult{
+		Tokens:     []types.TokenI{types.GetNativeToken()},
+		Pagination: &query.PageResponse{},
+	})
+	suite.Equal(data2, data)
+
+	// the page response is returned with the page
+	params.Pagination = &query.PageRequest{Limit: 1, CountTotal: true}
+	req.Data = suite.legacyAmino.MustMarshalJSON(params)
+	data, err = querier(ctx, []string{types.QueryTokens}, req)
 	suite.Nil(err)
 
-	data2 := codec.MustMarshalJSONIndent(suite.legacyAmino, []types.TokenI{types.GetNativeToken()})
+	data2 = codec.MustMarshalJSONIndent(suite.legacyAmino, types.QueryTokensResult{
+		Tokens:     []types.TokenI{types.GetNativeToken()},
+		Pagination: &query.PageResponse{Total: 1},
+	})
 	suite.Equal(data2, data)
+
+	// no tokens are non-mintable or begin with the prefix
+	for _, params := range []types.QueryTokensParams{{Mintable: types.False}, {SymbolPrefix: "btc"}} {
+		req.Data = suite.legacyAmino.MustMarshalJSON(params)
+		data, err = querier(ctx, []string{types.QueryTokens}, req)
+		suite.Nil(err)
+
+		data2 = codec.MustMarshalJSONIndent(suite.legacyAmino, types.QueryTokensResult{Pagination: &query.PageResponse{}})
+		suite.Equal(data2, data)
+	}
 }
 
 func (suite *KeeperTestSuite) TestQueryFees() {
@@ -90,3 +117,26 @@ func (suite *KeeperTestSuite) TestQueryFees() {
 	suite.Equal(fmt.Sprintf("60000%s", types.GetNativeToken().MinUnit), fee.IssueFee.String())
 	suite.Equal(fmt.Sprintf("6000%s", types.GetNativeToken().MinUnit), fee.MintFee.String())
 }
+
+func (suite *KeeperTestSuite) TestQuerySupply() {
+	ctx := suite.ctx
+	querier := keeper.NewQuerier(suite.keeper, suite.legacyAmino)
+
+	token := types.GetNativeToken()
+	params := types.QuerySupplyParams{
+		Denom: token.Symbol,
+	}
+	bz := suite.legacyAmino.MustMarshalJSON(params)
+	query := abci.RequestQuery{
+		Path: fmt.Sprintf("/custom/%s/%s", types.QuerierRoute, types.QuerySupply),
+		Data: bz,
+	}
+
+	data, err := querier(ctx, []string{types.QuerySupply}, query)
+	suite.Nil(err)
+
+	var supply types.QuerySupplyResponse
+	suite.legacyAmino.MustUnmarshalJSON(data, &supply)
+	suite.Equal(token.MinUnit, supply.Supply.TotalSupply.Denom)
+	suite.Equal(token.Symbol, supply.MainSupply.TotalSupply.Denom)
+}
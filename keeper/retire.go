@@ -0,0 +1,147 @@
+package keeper
+
+import (
+	"fmt"
+
+	gogotypes "github.com/gogo/protobuf/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/irismod/token/types"
+)
+
+// GetRetiredToken returns the tombstone of the retired token with the specified symbol
+func (k Keeper) GetRetiredToken(ctx sdk.Context, symbol string) (retired types.RetiredToken, err error) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.KeyRetiredToken(symbol))
+	if bz == nil {
+		return retired, sdkerrors.Wrap(types.ErrTokenNotExists, fmt.Sprintf("retired token %s does not exist", symbol))
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &retired)
+	return retired, nil
+}
+
+// GetAllRetiredTokens returns the tombstones of all the retired tokens
+func (k Keeper) GetAllRetiredTokens(ctx sdk.Context) (retiredTokens []types.RetiredToken) {
+	store := ctx.KVStore(k.storeKey)
+
+	it := sdk.KVStorePrefixIterator(store, types.PrefixRetiredTokens)
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		var retired types.RetiredToken
+		k.cdc.MustUnmarshalBinaryBare(it.Value(), &retired)
+
+		retiredTokens = append(retiredTokens, retired)
+	}
+	return
+}
+
+// SetRetiredToken saves the tombstone of the retired token, indexed by both symbol and min_unit
+func (k Keeper) SetRetiredToken(ctx sdk.Context, retired types.RetiredToken) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshalBinaryBare(&retired)
+	store.Set(types.KeyRetiredToken(retired.Symbol), bz)
+
+	bz = k.cdc.MustMarshalBinaryBare(&gogotypes.StringValue{Value: retired.Symbol})
+	store.Set(types.KeyRetiredMinUnit(retired.MinUnit), bz)
+}
+
+func (k Keeper) deleteRetiredToken(ctx sdk.Context, retired types.RetiredToken) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Delete(types.KeyRetiredToken(retired.Symbol))
+	store.Delete(types.KeyRetiredMinUnit(retired.MinUnit))
+}
+
+// checkRetiredToken returns an error if the symbol or min_unit belongs to a token retired within the cooldown.
+// The tombstones whose cooldown has elapsed are removed
+func (k Keeper) checkRetiredToken(ctx sdk.Context, symbol, minUnit string) error {
+	store := ctx.KVStore(k.storeKey)
+
+	symbols := []string{symbol}
+	if bz := store.Get(types.KeyRetiredMinUnit(minUnit)); bz != nil {
+		var retiredSymbol gogotypes.StringValue
+		k.cdc.MustUnmarshalBinaryBare(bz, &retiredSymbol)
+
+		symbols = append(symbols, retiredSymbol.Value)
+	}
+
+	cooldown := int64(k.GetParamSet(ctx).RetiredSymbolCooldown)
+	for _, s := range symbols {
+		retired, err := k.GetRetiredToken(ctx, s)
+		if err != nil {
+			continue
+		}
+
+		if availableHeight := retired.RetireHeight + cooldown; ctx.BlockHeight() < availableHeight {
+			return sdkerrors.Wrapf(
+				types.ErrSymbolRetired,
+				"the token %s (%s) was retired at height %d and can not be re-registered before height %d",
+				retired.Symbol, retired.MinUnit, retired.RetireHeight, availableHeight,
+			)
+		}
+
+		k.deleteRetiredToken(ctx, retired)
+	}
+	return nil
+}
+
+// RetireToken removes the token whose total supply is zero and records the retirement
+func (k Keeper) RetireToken(ctx sdk.Context, msg types.MsgRetireToken) error {
+	tokenI, err := k.GetToken(ctx, msg.Symbol)
+	if err != nil {
+		return err
+	}
+
+	token := tokenI.(*types.Token)
+
+	if err := k.checkRole(ctx, *token, msg.Owner, types.RoleAdmin); err != nil {
+		return err
+	}
+
+	if supply := k.getTokenSupply(ctx, token.MinUnit); !supply.IsZero() {
+		return sdkerrors.Wrapf(types.ErrNonZeroSupply, "the total supply of the token %s is %s%s", msg.Symbol, supply, token.MinUnit)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+
+	// remove the token and its indexes
+	store.Delete(types.KeySymbol(token.Symbol))
+	store.Delete(types.KeyMinUint(token.MinUnit))
+	store.Delete(types.KeyTokens(token.Owner, token.Symbol))
+
+	// remove the state attached to the token so that it is not inherited on re-registration
+	store.Delete(types.KeyBurnTokenAmt(token.MinUnit))
+	for _, addr := range k.GetFrozenAccounts(ctx, token.Symbol) {
+		k.deleteFrozenAccount(ctx, token.Symbol, addr)
+	}
+	for _, minter := range k.GetMinters(ctx, token.Symbol) {
+		k.deleteMinter(ctx, token.Symbol, minter.Address)
+	}
+	for _, role := range k.GetRoles(ctx, token.Symbol) {
+		k.deleteRole(ctx, token.Symbol, role.Address, role.Role)
+	}
+	if transfer, err := k.GetPendingTransfer(ctx, token.Symbol); err == nil {
+		k.RemovePendingTransfer(ctx, transfer)
+	}
+	k.deleteSnapshots(ctx, token.Symbol)
+	k.deleteHolders(ctx, token.MinUnit)
+	k.retireTokenHistory(ctx, token.Symbol, ctx.BlockHeight())
+	k.removeLeaseQueue(ctx, *token)
+	if err := k.payDividends(ctx, *token); err != nil {
+		return err
+	}
+
+	k.SetRetiredToken(ctx, types.RetiredToken{
+		Symbol:       token.Symbol,
+		MinUnit:      token.MinUnit,
+		Owner:        token.Owner,
+		RetireHeight: ctx.BlockHeight(),
+	})
+	return nil
+}
@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/irismod/token/types"
+)
+
+// HasRole returns true if the role of the specified symbol is granted to the address
+func (k Keeper) HasRole(ctx sdk.Context, symbol string, addr sdk.AccAddress, role types.Role) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.KeyRole(symbol, addr, role))
+}
+
+// GetRoles returns all the roles granted for the specified symbol
+func (k Keeper) GetRoles(ctx sdk.Context, symbol string) (roles []types.RoleGrant) {
+	store := ctx.KVStore(k.storeKey)
+
+	it := sdk.KVStorePrefixIterator(store, types.KeyRoles(symbol))
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		var role types.RoleGrant
+		k.cdc.MustUnmarshalBinaryBare(it.Value(), &role)
+
+		roles = append(roles, role)
+	}
+	return
+}
+
+// GetAllRoles returns the roles granted for all the tokens
+func (k Keeper) GetAllRoles(ctx sdk.Context) (roles []types.RoleGrant) {
+	store := ctx.KVStore(k.storeKey)
+
+	it := sdk.KVStorePrefixIterator(store, types.PrefixRoles)
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		var role types.RoleGrant
+		k.cdc.MustUnmarshalBinaryBare(it.Value(), &role)
+
+		roles = append(roles, role)
+	}
+	return
+}
+
+// SetRole saves the granted role
+func (k Keeper) SetRole(ctx sdk.Context, role types.RoleGrant) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&role)
+
+	store.Set(types.KeyRole(role.Symbol, role.Address, role.Role), bz)
+}
+
+func (k Keeper) deleteRole(ctx sdk.Context, symbol string, addr sdk.AccAddress, role types.Role) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyRole(symbol, addr, role))
+}
+
+// checkRole returns an error if the address is neither the owner of the token nor granted the role.
+// Neither the owner nor the granted roles of a lapsed token hold any privilege until the symbol lease is renewed
+func (k Keeper) checkRole(ctx sdk.Context, token types.Token, addr sdk.AccAddress, role types.Role) error {
+	if err := checkLease(token); err != nil {
+		return err
+	}
+	return k.checkGrantedRole(ctx, token, addr, role)
+}
+
+// checkGrantedRole returns an error if the address is neither the owner of the token nor granted the role, regardless of the symbol lease
+func (k Keeper) checkGrantedRole(ctx sdk.Context, token types.Token, addr sdk.AccAddress, role types.Role) error {
+	if addr.Equals(token.Owner) || k.HasRole(ctx, token.Symbol, addr, role) {
+		return nil
+	}
+	return sdkerrors.Wrapf(types.ErrInvalidOwner, "the address %s is neither the owner nor granted the role %s of the token %s", addr.String(), role, token.Symbol)
+}
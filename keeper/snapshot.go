@@ -0,0 +1,184 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/irismod/token/types"
+)
+
+// GetSnapshot returns the snapshot of the specified symbol and id
+func (k Keeper) GetSnapshot(ctx sdk.Context, symbol string, id uint64) (snapshot types.Snapshot, err error) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.KeySnapshot(symbol, id))
+	if bz == nil {
+		return snapshot, sdkerrors.Wrap(types.ErrSnapshotNotExists, fmt.Sprintf("snapshot %d of the token %s does not exist", id, symbol))
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &snapshot)
+	return snapshot, nil
+}
+
+// GetLatestSnapshotID returns the id of the latest snapshot of the specified symbol, or 0 if none is taken
+func (k Keeper) GetLatestSnapshotID(ctx sdk.Context, symbol string) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	it := sdk.KVStoreReversePrefixIterator(store, types.KeySnapshots(symbol))
+	defer it.Close()
+
+	if !it.Valid() {
+		return 0
+	}
+
+	var snapshot types.Snapshot
+	k.cdc.MustUnmarshalBinaryBare(it.Value(), &snapshot)
+	return snapshot.ID
+}
+
+// GetAllSnapshots returns the snapshots of all the tokens
+func (k Keeper) GetAllSnapshots(ctx sdk.Context) (snapshots []types.Snapshot) {
+	store := ctx.KVStore(k.storeKey)
+
+	it := sdk.KVStorePrefixIterator(store, types.PrefixSnapshots)
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		var snapshot types.Snapshot
+		k.cdc.MustUnmarshalBinaryBare(it.Value(), &snapshot)
+
+		snapshots = append(snapshots, snapshot)
+	}
+	return
+}
+
+// SetSnapshot saves the snapshot
+func (k Keeper) SetSnapshot(ctx sdk.Context, snapshot types.Snapshot) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&snapshot)
+
+	store.Set(types.KeySnapshot(snapshot.Symbol, snapshot.ID), bz)
+}
+
+// GetAllSnapshotBalances returns the balances recorded for the snapshots of all the tokens
+func (k Keeper) GetAllSnapshotBalances(ctx sdk.Context) (balances []types.SnapshotBalance) {
+	store := ctx.KVStore(k.storeKey)
+
+	it := sdk.KVStorePrefixIterator(store, types.PrefixSnapshotBalances)
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		var balance types.SnapshotBalance
+		k.cdc.MustUnmarshalBinaryBare(it.Value(), &balance)
+
+		balances = append(balances, balance)
+	}
+	return
+}
+
+// SetSnapshotBalance saves the balance of the holder recorded for the snapshot
+func (k Keeper) SetSnapshotBalance(ctx sdk.Context, balance types.SnapshotBalance) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&balance)
+
+	store.Set(types.KeySnapshotBalance(balance.Symbol, balance.Address, balance.SnapshotID), bz)
+}
+
+// SnapshotToken takes a new snapshot of the balances of the token holders and returns its id
+func (k Keeper) SnapshotToken(ctx sdk.Context, msg types.MsgSnapshotToken) (uint64, error) {
+	tokenI, err := k.GetToken(ctx, msg.Symbol)
+	if err != nil {
+		return 0, err
+	}
+
+	token := tokenI.(*types.Token)
+
+	if err := k.checkRole(ctx, *token, msg.Owner, types.RoleAdmin); err != nil {
+		return 0, err
+	}
+
+	id := k.GetLatestSnapshotID(ctx, token.Symbol) + 1
+	k.SetSnapshot(ctx, types.Snapshot{
+		Symbol: token.Symbol,
+		ID:     id,
+		Height: ctx.BlockHeight(),
+	})
+	return id, nil
+}
+
+// GetBalanceAt returns the balance of the holder at the specified snapshot of the token
+func (k Keeper) GetBalanceAt(ctx sdk.Context, symbol string, id uint64, addr sdk.AccAddress) (sdk.Coin, error) {
+	token, err := k.GetToken(ctx, symbol)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if _, err := k.GetSnapshot(ctx, token.GetSymbol(), id); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// the first balance recorded at or after the snapshot is the balance at the snapshot
+	store := ctx.KVStore(k.storeKey)
+	it := store.Iterator(
+		types.KeySnapshotBalance(token.GetSymbol(), addr, id),
+		sdk.PrefixEndBytes(types.KeySnapshotBalancesByHolder(token.GetSymbol(), addr)),
+	)
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		var balance types.SnapshotBalance
+		k.cdc.MustUnmarshalBinaryBare(it.Value(), &balance)
+
+		if balance.Address.Equals(addr) {
+			return sdk.NewCoin(token.GetMinUnit(), balance.Amount), nil
+		}
+	}
+
+	// the balance has not changed since the snapshot
+	return k.bankKeeper.GetBalance(ctx, addr, token.GetMinUnit()), nil
+}
+
+// UpdateSnapshotBalances records the current balances of the holder for the latest snapshots of the given tokens.
+// It must be called before the balances change, and only the first call after a snapshot records the balance
+func (k Keeper) UpdateSnapshotBalances(ctx sdk.Context, addr sdk.AccAddress, coins sdk.Coins) {
+	store := ctx.KVStore(k.storeKey)
+
+	for _, coin := range coins {
+		token, err := k.GetToken(ctx, coin.Denom)
+		if err != nil || token.GetMinUnit() != coin.Denom {
+			continue
+		}
+
+		id := k.GetLatestSnapshotID(ctx, token.GetSymbol())
+		if id == 0 || store.Has(types.KeySnapshotBalance(token.GetSymbol(), addr, id)) {
+			continue
+		}
+
+		k.SetSnapshotBalance(ctx, types.SnapshotBalance{
+			Symbol:     token.GetSymbol(),
+			SnapshotID: id,
+			Address:    addr,
+			Amount:     k.bankKeeper.GetBalance(ctx, addr, coin.Denom).Amount,
+		})
+	}
+}
+
+// deleteSnapshots removes the snapshots of the token and the balances recorded for them
+func (k Keeper) deleteSnapshots(ctx sdk.Context, symbol string) {
+	store := ctx.KVStore(k.storeKey)
+
+	var keys [][]byte
+	for _, prefix := range [][]byte{types.KeySnapshots(symbol), types.KeySnapshotBalances(symbol)} {
+		it := sdk.KVStorePrefixIterator(store, prefix)
+		for ; it.Valid(); it.Next() {
+			keys = append(keys, it.Key())
+		}
+		it.Close()
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
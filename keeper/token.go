@@ -5,8 +5,10 @@ import (
 
 	gogotypes "github.com/gogo/protobuf/types"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/irismod/token/types"
 )
@@ -45,6 +47,52 @@ func (k Keeper) GetTokens(ctx sdk.Context, owner sdk.AccAddress) (tokens []types
 	return
 }
 
+// GetPaginatedTokens returns a page of the tokens filtered by the owner, the mintable flag and the symbol prefix
+func (k Keeper) GetPaginatedTokens(ctx sdk.Context, params types.QueryTokensParams) ([]types.TokenI, *query.PageResponse, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	// the tokens of an owner are iterated through the owner index, in which the keys also end with the symbols
+	var tokenStore sdk.KVStore
+	if params.Owner.Empty() {
+		tokenStore = prefix.NewStore(store, types.KeySymbol(params.SymbolPrefix))
+	} else {
+		tokenStore = prefix.NewStore(store, types.KeyTokens(params.Owner, params.SymbolPrefix))
+	}
+
+	var tokens []types.TokenI
+	pageRes, err := query.FilteredPaginate(tokenStore, params.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		var token types.Token
+		if params.Owner.Empty() {
+			if err := k.cdc.UnmarshalBinaryBare(value, &token); err != nil {
+				return false, err
+			}
+		} else {
+			var symbol gogotypes.StringValue
+			if err := k.cdc.UnmarshalBinaryBare(value, &symbol); err != nil {
+				return false, err
+			}
+
+			var err error
+			if token, err = k.getToken(ctx, symbol.Value); err != nil {
+				return false, err
+			}
+		}
+
+		if len(params.Mintable) > 0 && token.Mintable != params.Mintable.ToBool() {
+			return false, nil
+		}
+
+		if accumulate {
+			tokens = append(tokens, &token)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return tokens, pageRes, nil
+}
+
 // GetToken returns the token of the specified symbol or minUint
 func (k Keeper) GetToken(ctx sdk.Context, denom string) (types.TokenI, error) {
 	store := ctx.KVStore(k.storeKey)
@@ -77,6 +125,10 @@ func (k Keeper) AddToken(ctx sdk.Context, token types.Token) error {
 		return sdkerrors.Wrapf(types.ErrMinUnitAlreadyExists, "min-unit already exists: %s", token.MinUnit)
 	}
 
+	if err := k.checkRetiredToken(ctx, token.Symbol, token.MinUnit); err != nil {
+		return err
+	}
+
 	// set token
 	if err := k.setToken(ctx, token); err != nil {
 		return err
@@ -92,6 +144,7 @@ func (k Keeper) AddToken(ctx sdk.Context, token types.Token) error {
 		return err
 	}
 
+	k.setLeaseQueue(ctx, token)
 	return nil
 }
 
@@ -106,6 +159,47 @@ func (k Keeper) HasToken(ctx sdk.Context, denom string) bool {
 	return store.Has(types.KeyMinUint(denom))
 }
 
+// AddBurnCoin saves the total amount of the burned tokens
+func (k Keeper) AddBurnCoin(ctx sdk.Context, coin sdk.Coin) {
+	total := k.GetBurnCoin(ctx, coin.Denom).Add(coin)
+
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&total)
+
+	store.Set(types.KeyBurnTokenAmt(coin.Denom), bz)
+}
+
+// GetBurnCoin returns the total amount of the burned tokens of the specified min_unit
+func (k Keeper) GetBurnCoin(ctx sdk.Context, minUnit string) sdk.Coin {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.KeyBurnTokenAmt(minUnit))
+	if bz == nil {
+		return sdk.NewCoin(minUnit, sdk.ZeroInt())
+	}
+
+	var coin sdk.Coin
+	k.cdc.MustUnmarshalBinaryBare(bz, &coin)
+	return coin
+}
+
+// GetAllBurnCoin returns the total amount of all the burned tokens
+func (k Keeper) GetAllBurnCoin(ctx sdk.Context) []sdk.Coin {
+	store := ctx.KVStore(k.storeKey)
+
+	it := sdk.KVStorePrefixIterator(store, types.PrefixBurnTokenAmt)
+	defer it.Close()
+
+	var coins []sdk.Coin
+	for ; it.Valid(); it.Next() {
+		var coin sdk.Coin
+		k.cdc.MustUnmarshalBinaryBare(it.Value(), &coin)
+
+		coins = append(coins, coin)
+	}
+	return coins
+}
+
 // GetParamSet returns token params from the global param store
 func (k Keeper) GetParamSet(ctx sdk.Context) types.Params {
 	var p types.Params
@@ -156,14 +250,48 @@ func (k Keeper) getToken(ctx sdk.Context, symbol string) (token types.Token, err
 }
 
 // reset all index by DstOwner of token for query-token command
-func (k Keeper) resetStoreKeyForQueryToken(ctx sdk.Context, msg types.MsgTransferTokenOwner, token types.Token) error {
+func (k Keeper) resetStoreKeyForQueryToken(ctx sdk.Context, srcOwner, dstOwner sdk.AccAddress, token types.Token) error {
 	store := ctx.KVStore(k.storeKey)
 
 	// delete the old key
-	store.Delete(types.KeyTokens(msg.SrcOwner, token.Symbol))
+	store.Delete(types.KeyTokens(srcOwner, token.Symbol))
 
 	// add the new key
-	return k.setWithOwner(ctx, msg.DstOwner, token.Symbol)
+	return k.setWithOwner(ctx, dstOwner, token.Symbol)
+}
+
+// GetSupply returns the supply breakdown of the token with the specified symbol or min_unit in both the min unit and the main unit
+func (k Keeper) GetSupply(ctx sdk.Context, denom string) (types.TokenSupply, types.TokenMainSupply, error) {
+	tokenI, err := k.GetToken(ctx, denom)
+	if err != nil {
+		return types.TokenSupply{}, types.TokenMainSupply{}, err
+	}
+
+	token := tokenI.(*types.Token)
+
+	totalSupply := k.getTokenSupply(ctx, token.MinUnit)
+	burned := k.GetBurnCoin(ctx, token.MinUnit)
+	maxSupply := token.MaxSupply.Mul(sdk.NewIntWithDecimal(1, int(token.Scale)))
+
+	// the burned tokens are still counted against the max supply
+	mintable := sdk.ZeroInt()
+	if token.Mintable {
+		mintable = sdk.MaxInt(maxSupply.Sub(totalSupply).Sub(burned.Amount), sdk.ZeroInt())
+	}
+
+	supply := types.TokenSupply{
+		TotalSupply: sdk.NewCoin(token.MinUnit, totalSupply),
+		MaxSupply:   sdk.NewCoin(token.MinUnit, maxSupply),
+		Mintable:    sdk.NewCoin(token.MinUnit, mintable),
+		Burned:      burned,
+		ModuleHeld:  k.bankKeeper.GetBalance(ctx, k.accountKeeper.GetModuleAddress(types.ModuleName), token.MinUnit),
+	}
+
+	mainSupply, err := supply.ToMainSupply(token)
+	if err != nil {
+		return types.TokenSupply{}, types.TokenMainSupply{}, err
+	}
+	return supply, mainSupply, nil
 }
 
 // getTokenSupply query issued tokens supply from the total supply
@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"fmt"
+
+	gogotypes "github.com/gogo/protobuf/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/irismod/token/types"
+)
+
+// GetPendingTransfer returns the pending transfer of the specified symbol
+func (k Keeper) GetPendingTransfer(ctx sdk.Context, symbol string) (transfer types.PendingTransfer, err error) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.KeyPendingTransfer(symbol))
+	if bz == nil {
+		return transfer, sdkerrors.Wrap(types.ErrTransferNotExists, fmt.Sprintf("pending transfer of the token %s does not exist", symbol))
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &transfer)
+	return transfer, nil
+}
+
+// GetPendingTransfersByRecipient returns all the pending transfers to the specified recipient
+func (k Keeper) GetPendingTransfersByRecipient(ctx sdk.Context, recipient sdk.AccAddress) (transfers []types.PendingTransfer) {
+	store := ctx.KVStore(k.storeKey)
+
+	it := sdk.KVStorePrefixIterator(store, types.KeyPendingTransferByRecipient(recipient, ""))
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		var symbol gogotypes.StringValue
+		k.cdc.MustUnmarshalBinaryBare(it.Value(), &symbol)
+
+		transfer, err := k.GetPendingTransfer(ctx, symbol.Value)
+		if err != nil {
+			continue
+		}
+		transfers = append(transfers, transfer)
+	}
+	return
+}
+
+// GetAllPendingTransfers returns the pending transfers of all the tokens
+func (k Keeper) GetAllPendingTransfers(ctx sdk.Context) (transfers []types.PendingTransfer) {
+	store := ctx.KVStore(k.storeKey)
+
+	it := sdk.KVStorePrefixIterator(store, types.PrefixPendingTransfers)
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		var transfer types.PendingTransfer
+		k.cdc.MustUnmarshalBinaryBare(it.Value(), &transfer)
+
+		transfers = append(transfers, transfer)
+	}
+	return
+}
+
+// GetExpiredPendingTransfers returns the pending transfers which expire at or before the specified height
+func (k Keeper) GetExpiredPendingTransfers(ctx sdk.Context, height int64) (transfers []types.PendingTransfer) {
+	store := ctx.KVStore(k.storeKey)
+
+	it := store.Iterator(types.PrefixPendingTransferQueue, sdk.PrefixEndBytes(types.KeyPendingTransferQueueByHeight(height)))
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		var symbol gogotypes.StringValue
+		k.cdc.MustUnmarshalBinaryBare(it.Value(), &symbol)
+
+		transfer, err := k.GetPendingTransfer(ctx, symbol.Value)
+		if err != nil {
+			continue
+		}
+		transfers = append(transfers, transfer)
+	}
+	return
+}
+
+// SetPendingTransfer saves the pending transfer along with its indexes
+func (k Keeper) SetPendingTransfer(ctx sdk.Context, transfer types.PendingTransfer) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshalBinaryBare(&transfer)
+	store.Set(types.KeyPendingTransfer(transfer.Symbol), bz)
+
+	symbol := k.cdc.MustMarshalBinaryBare(&gogotypes.StringValue{Value: transfer.Symbol})
+	store.Set(types.KeyPendingTransferByRecipient(transfer.DstOwner, transfer.Symbol), symbol)
+
+	// a zero expiration height means the transfer never expires
+	if transfer.ExpireHeight > 0 {
+		store.Set(types.KeyPendingTransferQueue(transfer.ExpireHeight, transfer.Symbol), symbol)
+	}
+}
+
+// RemovePendingTransfer deletes the pending transfer along with its indexes
+func (k Keeper) RemovePendingTransfer(ctx sdk.Context, transfer types.PendingTransfer) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Delete(types.KeyPendingTransfer(transfer.Symbol))
+	store.Delete(types.KeyPendingTransferByRecipient(transfer.DstOwner, transfer.Symbol))
+	if transfer.ExpireHeight > 0 {
+		store.Delete(types.KeyPendingTransferQueue(transfer.ExpireHeight, transfer.Symbol))
+	}
+}
@@ -0,0 +1,144 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/irismod/token/types"
+)
+
+// GetVestingSchedule returns the vesting schedule of the specified symbol and recipient
+func (k Keeper) GetVestingSchedule(ctx sdk.Context, symbol string, recipient sdk.AccAddress) (schedule types.VestingSchedule, err error) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.KeyVestingSchedule(symbol, recipient))
+	if bz == nil {
+		return schedule, sdkerrors.Wrap(types.ErrInvalidAllocation, fmt.Sprintf("vesting schedule of the token %s for %s does not exist", symbol, recipient))
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &schedule)
+	return schedule, nil
+}
+
+// GetAllVestingSchedules returns the vesting schedules of all the tokens
+func (k Keeper) GetAllVestingSchedules(ctx sdk.Context) (schedules []types.VestingSchedule) {
+	store := ctx.KVStore(k.storeKey)
+
+	it := sdk.KVStorePrefixIterator(store, types.PrefixVestingSchedules)
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		var schedule types.VestingSchedule
+		k.cdc.MustUnmarshalBinaryBare(it.Value(), &schedule)
+
+		schedules = append(schedules, schedule)
+	}
+	return
+}
+
+// GetEndedVestingSchedules returns the queued vesting schedules which end at or before the specified time
+func (k Keeper) GetEndedVestingSchedules(ctx sdk.Context, blockTime time.Time) (schedules []types.VestingSchedule) {
+	store := ctx.KVStore(k.storeKey)
+
+	it := store.Iterator(types.PrefixVestingQueue, sdk.PrefixEndBytes(types.KeyVestingQueueByTime(blockTime)))
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		bz := store.Get(it.Value())
+		if bz == nil {
+			continue
+		}
+
+		var schedule types.VestingSchedule
+		k.cdc.MustUnmarshalBinaryBare(bz, &schedule)
+
+		schedules = append(schedules, schedule)
+	}
+	return
+}
+
+// SetVestingSchedule saves the vesting schedule and queues it by the end time
+func (k Keeper) SetVestingSchedule(ctx sdk.Context, schedule types.VestingSchedule) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&schedule)
+
+	key := types.KeyVestingSchedule(schedule.Symbol, schedule.Recipient)
+	store.Set(key, bz)
+	store.Set(types.KeyVestingQueue(schedule.EndTime, schedule.Symbol, schedule.Recipient), key)
+}
+
+func (k Keeper) dequeueVestingSchedule(ctx sdk.Context, schedule types.VestingSchedule) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyVestingQueue(schedule.EndTime, schedule.Symbol, schedule.Recipient))
+}
+
+func (k Keeper) deleteVestingSchedule(ctx sdk.Context, schedule types.VestingSchedule) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyVestingSchedule(schedule.Symbol, schedule.Recipient))
+	k.dequeueVestingSchedule(ctx, schedule)
+}
+
+// GetVestingBalances returns the vested and unvested balances of the recipient, optionally of the specified symbol only
+func (k Keeper) GetVestingBalances(ctx sdk.Context, recipient sdk.AccAddress, symbol string) (balances []types.VestingBalance) {
+	for _, schedule := range k.GetAllVestingSchedules(ctx) {
+		if !schedule.Recipient.Equals(recipient) || (len(symbol) > 0 && schedule.Symbol != symbol) {
+			continue
+		}
+
+		vested := schedule.VestedAmount(ctx.BlockTime())
+		balances = append(balances, types.VestingBalance{
+			Symbol:    schedule.Symbol,
+			Recipient: schedule.Recipient,
+			Vested:    sdk.NewCoin(schedule.Total.Denom, vested),
+			Unvested:  sdk.NewCoin(schedule.Total.Denom, schedule.Total.Amount.Sub(vested)),
+		})
+	}
+	return
+}
+
+// ReleaseVesting sends the coins of the vesting schedule vested so far to the recipient and returns the released coin
+func (k Keeper) ReleaseVesting(ctx sdk.Context, msg types.MsgReleaseVesting) (sdk.Coin, error) {
+	schedule, err := k.GetVestingSchedule(ctx, msg.Symbol, msg.Recipient)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	releasable := sdk.NewCoin(schedule.Total.Denom, schedule.VestedAmount(ctx.BlockTime()).Sub(schedule.Released.Amount))
+	if !releasable.IsPositive() {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrInvalidAllocation, "no coins of the token %s have vested for %s since the last release", msg.Symbol, msg.Recipient)
+	}
+
+	if err := k.ValidateTransfer(ctx, msg.Recipient, sdk.NewCoins(releasable)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return k.ReleaseVestedCoins(ctx, schedule)
+}
+
+// ReleaseVestedCoins sends the newly vested coins of the schedule to the recipient and returns the released coin.
+// The schedule is removed from the store and the queue once it is fully released. Nothing is released while the token
+// is paused or the recipient is frozen for the token, and an ended schedule stays queued to be retried by the EndBlocker
+func (k Keeper) ReleaseVestedCoins(ctx sdk.Context, schedule types.VestingSchedule) (sdk.Coin, error) {
+	released := sdk.NewCoin(schedule.Total.Denom, schedule.VestedAmount(ctx.BlockTime()).Sub(schedule.Released.Amount))
+	if !released.IsPositive() || k.ValidateTransfer(ctx, schedule.Recipient, sdk.NewCoins(released)) != nil {
+		return sdk.NewCoin(schedule.Total.Denom, sdk.ZeroInt()), nil
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(
+		ctx, types.ModuleName, schedule.Recipient, sdk.NewCoins(released),
+	); err != nil {
+		return released, err
+	}
+
+	schedule.Released = schedule.Released.Add(released)
+	if schedule.Released.IsEqual(schedule.Total) {
+		k.deleteVestingSchedule(ctx, schedule)
+		return released, nil
+	}
+
+	k.SetVestingSchedule(ctx, schedule)
+	return released, nil
+}
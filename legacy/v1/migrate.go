@@ -0,0 +1,78 @@
+package v1
+
+import (
+	gogotypes "github.com/gogo/protobuf/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/irismod/token/types"
+)
+
+// MigrateStore converts the uint64 supplies of the stored tokens to sdk.Int and rebuilds
+// the owner and min_unit indexes of the tokens, removing the orphaned entries
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey, cdc codec.BinaryMarshaler) error {
+	store := ctx.KVStore(storeKey)
+
+	it := sdk.KVStorePrefixIterator(store, types.PrefixTokenForSymbol)
+	defer it.Close()
+
+	var tokens []types.Token
+	for ; it.Valid(); it.Next() {
+		var legacyToken Token
+		if err := cdc.UnmarshalBinaryBare(it.Value(), &legacyToken); err != nil {
+			return err
+		}
+
+		tokens = append(tokens, MigrateToken(legacyToken))
+	}
+
+	for _, prefix := range [][]byte{types.PrefixTokenForMinUint, types.PrefixTokens} {
+		deleteAll(store, prefix)
+	}
+
+	for _, token := range tokens {
+		bz, err := cdc.MarshalBinaryBare(&token)
+		if err != nil {
+			return err
+		}
+		store.Set(types.KeySymbol(token.Symbol), bz)
+
+		bz, err = cdc.MarshalBinaryBare(&gogotypes.StringValue{Value: token.Symbol})
+		if err != nil {
+			return err
+		}
+		store.Set(types.KeyMinUint(token.MinUnit), bz)
+		store.Set(types.KeyTokens(token.Owner, token.Symbol), bz)
+	}
+	return nil
+}
+
+// MigrateToken converts a legacy token to the current one
+func MigrateToken(token Token) types.Token {
+	return types.Token{
+		Symbol:        token.Symbol,
+		Name:          token.Name,
+		Scale:         token.Scale,
+		MinUnit:       token.MinUnit,
+		InitialSupply: sdk.NewIntFromUint64(token.InitialSupply),
+		MaxSupply:     sdk.NewIntFromUint64(token.MaxSupply),
+		Mintable:      token.Mintable,
+		Owner:         token.Owner,
+	}
+}
+
+// deleteAll removes all the entries with the specified prefix
+func deleteAll(store sdk.KVStore, prefix []byte) {
+	it := sdk.KVStorePrefixIterator(store, prefix)
+
+	var keys [][]byte
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+	}
+	it.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
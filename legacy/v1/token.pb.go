@@ -0,0 +1,578 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: legacy/v1/token.proto
+
+package v1
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Token defines the token stored before the store was versioned, with the supplies in uint64
+type Token struct {
+	Symbol        string                                        `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Name          string                                        `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Scale         uint32                                        `protobuf:"varint,3,opt,name=scale,proto3" json:"scale,omitempty"`
+	MinUnit       string                                        `protobuf:"bytes,4,opt,name=min_unit,json=minUnit,proto3" json:"min_unit,omitempty" yaml:"min_unit"`
+	InitialSupply uint64                                        `protobuf:"varint,5,opt,name=initial_supply,json=initialSupply,proto3" json:"initial_supply,omitempty" yaml:"initial_supply"`
+	MaxSupply     uint64                                        `protobuf:"varint,6,opt,name=max_supply,json=maxSupply,proto3" json:"max_supply,omitempty" yaml:"max_supply"`
+	Mintable      bool                                          `protobuf:"varint,7,opt,name=mintable,proto3" json:"mintable,omitempty"`
+	Owner         github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,8,opt,name=owner,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"owner,omitempty"`
+}
+
+func (m *Token) Reset()         { *m = Token{} }
+func (m *Token) String() string { return proto.CompactTextString(m) }
+func (*Token) ProtoMessage()    {}
+func (*Token) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f7e9f3a4b0768fe, []int{0}
+}
+func (m *Token) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Token) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Token.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Token) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Token.Merge(m, src)
+}
+func (m *Token) XXX_Size() int {
+	return m.Size()
+}
+func (m *Token) XXX_DiscardUnknown() {
+	xxx_messageInfo_Token.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Token proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*Token)(nil), "irismod.token.legacy.v1.Token")
+}
+
+func init() { proto.RegisterFile("legacy/v1/token.proto", fileDescriptor_1f7e9f3a4b0768fe) }
+
+var fileDescriptor_1f7e9f3a4b0768fe = []byte{
+	// 361 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0x3f, 0x8f, 0xda, 0x30,
+	0x18, 0xc6, 0x63, 0x4a, 0xf8, 0x63, 0x95, 0x56, 0x75, 0xa1, 0x4d, 0x19, 0x92, 0x28, 0x53, 0x16,
+	0x12, 0xa1, 0x76, 0xea, 0x04, 0x59, 0xba, 0xa7, 0xed, 0xd2, 0x05, 0x39, 0x89, 0x95, 0x5a, 0xc4,
+	0x76, 0x14, 0x1b, 0x4a, 0xbe, 0x45, 0x3f, 0x16, 0x23, 0xd3, 0xe9, 0xa6, 0xe8, 0x0e, 0xbe, 0x01,
+	0xe3, 0x4d, 0x27, 0x92, 0x80, 0xee, 0x26, 0xbf, 0xcf, 0xfb, 0x3c, 0x3f, 0xdb, 0xd2, 0x03, 0x27,
+	0x19, 0x49, 0x71, 0x5c, 0xfa, 0xdb, 0xb9, 0xaf, 0xc4, 0x9a, 0x70, 0x2f, 0x2f, 0x84, 0x12, 0xe8,
+	0x33, 0x2d, 0xa8, 0x64, 0x22, 0xf1, 0x9a, 0x65, 0x13, 0xf2, 0xb6, 0xf3, 0xe9, 0x38, 0x15, 0xa9,
+	0xa8, 0x33, 0xfe, 0x65, 0x6a, 0xe2, 0xce, 0x5d, 0x07, 0xea, 0xbf, 0x2e, 0x49, 0xf4, 0x09, 0xf6,
+	0x64, 0xc9, 0x22, 0x91, 0x19, 0xc0, 0x06, 0xee, 0x30, 0x6c, 0x15, 0x42, 0xb0, 0xcb, 0x31, 0x23,
+	0x46, 0xa7, 0xde, 0xd6, 0x33, 0x1a, 0x43, 0x5d, 0xc6, 0x38, 0x23, 0xc6, 0x1b, 0x1b, 0xb8, 0xa3,
+	0xb0, 0x11, 0xc8, 0x83, 0x03, 0x46, 0xf9, 0x6a, 0xc3, 0xa9, 0x32, 0xba, 0x97, 0x74, 0xf0, 0xf1,
+	0x5c, 0x59, 0xef, 0x4b, 0xcc, 0xb2, 0xef, 0xce, 0xd5, 0x71, 0xc2, 0x3e, 0xa3, 0xfc, 0x37, 0xa7,
+	0x0a, 0x2d, 0xe0, 0x3b, 0xca, 0xa9, 0xa2, 0x38, 0x5b, 0xc9, 0x4d, 0x9e, 0x67, 0xa5, 0xa1, 0xdb,
+	0xc0, 0xed, 0x06, 0x5f, 0xce, 0x95, 0x35, 0x69, 0xa8, 0xd7, 0xbe, 0x13, 0x8e, 0xda, 0xc5, 0xcf,
+	0x5a, 0xa3, 0x6f, 0x10, 0x32, 0xbc, 0xbb, 0xd2, 0xbd, 0x9a, 0x9e, 0x9c, 0x2b, 0xeb, 0x43, 0xfb,
+	0xe6, 0xcd, 0x73, 0xc2, 0x21, 0xc3, 0xbb, 0x96, 0x9a, 0xd6, 0xff, 0x54, 0x38, 0xca, 0x88, 0xd1,
+	0xb7, 0x81, 0x3b, 0x08, 0x6f, 0x1a, 0xfd, 0x80, 0xba, 0xf8, 0xc7, 0x49, 0x61, 0x0c, 0x6c, 0xe0,
+	0xbe, 0x0d, 0xe6, 0x4f, 0x95, 0x35, 0x4b, 0xa9, 0xfa, 0xbb, 0x89, 0xbc, 0x58, 0x30, 0x3f, 0x16,
+	0x92, 0x09, 0xd9, 0x1e, 0x33, 0x99, 0xac, 0x7d, 0x55, 0xe6, 0x44, 0x7a, 0xcb, 0x38, 0x5e, 0x26,
+	0x49, 0x41, 0xa4, 0x0c, 0x1b, 0x3e, 0x58, 0xec, 0x1f, 0x4d, 0x6d, 0x7f, 0x34, 0xc1, 0xe1, 0x68,
+	0x82, 0x87, 0xa3, 0x09, 0xfe, 0x9f, 0x4c, 0xed, 0x70, 0x32, 0xb5, 0xfb, 0x93, 0xa9, 0xfd, 0x71,
+	0x5e, 0xdc, 0xd9, 0x16, 0xd6, 0xb4, 0xe8, 0xdf, 0x5a, 0x8d, 0x7a, 0x75, 0x43, 0x5f, 0x9f, 0x07,
+	0x00, 0xf2, 0x69, 0x18, 0x5d, 0xe9, 0x01, 0x00, 0x00,
+}
+
+func (m *Token) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Token) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Token) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Mintable {
+		i--
+		if m.Mintable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxSupply != 0 {
+		i = encodeVarintToken(dAtA, i, uint64(m.MaxSupply))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.InitialSupply != 0 {
+		i = encodeVarintToken(dAtA, i, uint64(m.InitialSupply))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.MinUnit) > 0 {
+		i -= len(m.MinUnit)
+		copy(dAtA[i:], m.MinUnit)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.MinUnit)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Scale != 0 {
+		i = encodeVarintToken(dAtA, i, uint64(m.Scale))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintToken(dAtA []byte, offset int, v uint64) int {
+	offset -= sovToken(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Token) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	if m.Scale != 0 {
+		n += 1 + sovToken(uint64(m.Scale))
+	}
+	l = len(m.MinUnit)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	if m.InitialSupply != 0 {
+		n += 1 + sovToken(uint64(m.InitialSupply))
+	}
+	if m.MaxSupply != 0 {
+		n += 1 + sovToken(uint64(m.MaxSupply))
+	}
+	if m.Mintable {
+		n += 2
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	return n
+}
+
+func sovToken(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozToken(x uint64) (n int) {
+	return sovToken(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Token) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Token: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Token: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scale", wireType)
+			}
+			m.Scale = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Scale |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinUnit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinUnit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialSupply", wireType)
+			}
+			m.InitialSupply = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitialSupply |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			m.MaxSupply = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSupply |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mintable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Mintable = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthToken
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipToken(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowToken
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthToken
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupToken
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthToken
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthToken        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowToken          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupToken = fmt.Errorf("proto: unexpected end of group")
+)
@@ -7,6 +7,7 @@ import (
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 	gogotypes "github.com/gogo/protobuf/types"
 
@@ -32,6 +33,117 @@ func NewDecodeStore(cdc codec.Marshaler) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshalBinaryBare(kvA.Value, &symbolA)
 			cdc.MustUnmarshalBinaryBare(kvB.Value, &symbolB)
 			return fmt.Sprintf("%v\n%v", symbolA, symbolB)
+		case bytes.Equal(kvA.Key[:1], types.PrefixBurnTokenAmt):
+			var coinA, coinB sdk.Coin
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &coinA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &coinB)
+			return fmt.Sprintf("%v\n%v", coinA, coinB)
+		case bytes.Equal(kvA.Key[:1], types.PrefixFrozenAccounts):
+			var frozenA, frozenB gogotypes.BoolValue
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &frozenA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &frozenB)
+			return fmt.Sprintf("%v\n%v", frozenA, frozenB)
+		case bytes.Equal(kvA.Key[:1], types.PrefixMinters):
+			var minterA, minterB types.Minter
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &minterA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &minterB)
+			return fmt.Sprintf("%v\n%v", minterA, minterB)
+		case bytes.Equal(kvA.Key[:1], types.PrefixPendingTransfers):
+			var transferA, transferB types.PendingTransfer
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &transferA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &transferB)
+			return fmt.Sprintf("%v\n%v", transferA, transferB)
+		case bytes.Equal(kvA.Key[:1], types.PrefixPendingTransfersByRecipient),
+			bytes.Equal(kvA.Key[:1], types.PrefixPendingTransferQueue):
+			var symbolA, symbolB gogotypes.StringValue
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &symbolA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &symbolB)
+			return fmt.Sprintf("%v\n%v", symbolA, symbolB)
+		case bytes.Equal(kvA.Key[:1], types.PrefixRoles):
+			var roleA, roleB types.RoleGrant
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &roleA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &roleB)
+			return fmt.Sprintf("%v\n%v", roleA, roleB)
+		case bytes.Equal(kvA.Key[:1], types.PrefixVestingSchedules):
+			var scheduleA, scheduleB types.VestingSchedule
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &scheduleA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &scheduleB)
+			return fmt.Sprintf("%v\n%v", scheduleA, scheduleB)
+		case bytes.Equal(kvA.Key[:1], types.PrefixRetiredTokens):
+			var retiredA, retiredB types.RetiredToken
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &retiredA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &retiredB)
+			return fmt.Sprintf("%v\n%v", retiredA, retiredB)
+		case bytes.Equal(kvA.Key[:1], types.PrefixRetiredMinUnits):
+			var symbolA, symbolB gogotypes.StringValue
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &symbolA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &symbolB)
+			return fmt.Sprintf("%v\n%v", symbolA, symbolB)
+		case bytes.Equal(kvA.Key[:1], types.PrefixSnapshots):
+			var snapshotA, snapshotB types.Snapshot
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &snapshotA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &snapshotB)
+			return fmt.Sprintf("%v\n%v", snapshotA, snapshotB)
+		case bytes.Equal(kvA.Key[:1], types.PrefixSnapshotBalances):
+			var balanceA, balanceB types.SnapshotBalance
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &balanceA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &balanceB)
+			return fmt.Sprintf("%v\n%v", balanceA, balanceB)
+		case bytes.Equal(kvA.Key[:1], types.PrefixDividendIndexes):
+			var indexA, indexB types.DividendIndex
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &indexA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &indexB)
+			return fmt.Sprintf("%v\n%v", indexA, indexB)
+		case bytes.Equal(kvA.Key[:1], types.PrefixDividendCheckpoints):
+			var checkpointA, checkpointB types.DividendCheckpoint
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &checkpointA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &checkpointB)
+			return fmt.Sprintf("%v\n%v", checkpointA, checkpointB)
+		case bytes.Equal(kvA.Key[:1], types.PrefixAuctions):
+			var auctionA, auctionB types.Auction
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &auctionA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &auctionB)
+			return fmt.Sprintf("%v\n%v", auctionA, auctionB)
+		case bytes.Equal(kvA.Key[:1], types.PrefixBids):
+			var bidA, bidB types.Bid
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &bidA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &bidB)
+			return fmt.Sprintf("%v\n%v", bidA, bidB)
+		case bytes.Equal(kvA.Key[:1], types.PrefixSymbolReservations):
+			var reservationA, reservationB types.SymbolReservation
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &reservationA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &reservationB)
+			return fmt.Sprintf("%v\n%v", reservationA, reservationB)
+		case bytes.Equal(kvA.Key[:1], types.PrefixAuctionQueue),
+			bytes.Equal(kvA.Key[:1], types.PrefixSymbolReservationQueue),
+			bytes.Equal(kvA.Key[:1], types.PrefixLeaseQueue):
+			var symbolA, symbolB gogotypes.StringValue
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &symbolA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &symbolB)
+			return fmt.Sprintf("%v\n%v", symbolA, symbolB)
+		case bytes.Equal(kvA.Key[:1], types.KeyStoreVersion):
+			var versionA, versionB gogotypes.UInt64Value
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &versionA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &versionB)
+			return fmt.Sprintf("%v\n%v", versionA, versionB)
+		case bytes.Equal(kvA.Key[:1], types.PrefixHolders),
+			bytes.Equal(kvA.Key[:1], types.PrefixHoldersByBalance):
+			var holderA, holderB types.Holder
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &holderA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &holderB)
+			return fmt.Sprintf("%v\n%v", holderA, holderB)
+		case bytes.Equal(kvA.Key[:1], types.PrefixTokenHistory), bytes.Equal(kvA.Key[:1], types.PrefixRetiredTokenHistory):
+			var entryA, entryB types.TokenHistoryEntry
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &entryA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &entryB)
+			return fmt.Sprintf("%v\n%v", entryA, entryB)
+		case bytes.Equal(kvA.Key[:1], types.PrefixHolderCounts):
+			var countA, countB gogotypes.UInt64Value
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &countA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &countB)
+			return fmt.Sprintf("%v\n%v", countA, countB)
+		case bytes.Equal(kvA.Key[:1], types.PrefixVestingQueue):
+			return fmt.Sprintf("%X\n%X", kvA.Value, kvB.Value)
 		default:
 			panic(fmt.Sprintf("invalid %s key prefix %X", types.ModuleName, kvA.Key[:1]))
 		}
@@ -9,15 +9,22 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 
 	"github.com/irismod/token/types"
 )
 
 // Simulation parameter constants
 const (
-	TokenTaxRate      = "token_tax_rate"
-	IssueTokenBaseFee = "issue_token_base_fee"
-	MintTokenFeeRatio = "mint_token_fee_ratio"
+	TokenTaxRate            = "token_tax_rate"
+	IssueTokenBaseFee       = "issue_token_base_fee"
+	MintTokenFeeRatio       = "mint_token_fee_ratio"
+	PendingTransferPeriod   = "pending_transfer_period"
+	MultiMintFeeRatio       = "multi_mint_fee_ratio"
+	RetiredSymbolCooldown   = "retired_symbol_cooldown"
+	BidCommitPeriod         = "bid_commit_period"
+	BidRevealPeriod         = "bid_reveal_period"
+	SymbolReservationPeriod = "symbol_reservation_period"
 )
 
 // RandomDec randomized sdk.RandomDec
@@ -36,6 +43,12 @@ func RandomizedGenState(simState *module.SimulationState) {
 	var tokenTaxRate sdk.Dec
 	var issueTokenBaseFee sdk.Int
 	var mintTokenFeeRatio sdk.Dec
+	var pendingTransferPeriod uint64
+	var multiMintFeeRatio sdk.Dec
+	var retiredSymbolCooldown uint64
+	var bidCommitPeriod uint64
+	var bidRevealPeriod uint64
+	var symbolReservationPeriod uint64
 	var tokens []types.Token
 
 	simState.AppParams.GetOrGenerate(
@@ -60,8 +73,38 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { mintTokenFeeRatio = sdk.NewDecWithPrec(int64(r.Intn(5)), 1) },
 	)
 
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, PendingTransferPeriod, &pendingTransferPeriod, simState.Rand,
+		func(r *rand.Rand) { pendingTransferPeriod = uint64(simtypes.RandIntBetween(r, 1, 1000)) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MultiMintFeeRatio, &multiMintFeeRatio, simState.Rand,
+		func(r *rand.Rand) { multiMintFeeRatio = sdk.NewDecWithPrec(int64(r.Intn(5)), 2) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, RetiredSymbolCooldown, &retiredSymbolCooldown, simState.Rand,
+		func(r *rand.Rand) { retiredSymbolCooldown = uint64(simtypes.RandIntBetween(r, 1, 1000)) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, BidCommitPeriod, &bidCommitPeriod, simState.Rand,
+		func(r *rand.Rand) { bidCommitPeriod = uint64(simtypes.RandIntBetween(r, 1, 1000)) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, BidRevealPeriod, &bidRevealPeriod, simState.Rand,
+		func(r *rand.Rand) { bidRevealPeriod = uint64(simtypes.RandIntBetween(r, 1, 1000)) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, SymbolReservationPeriod, &symbolReservationPeriod, simState.Rand,
+		func(r *rand.Rand) { symbolReservationPeriod = uint64(simtypes.RandIntBetween(r, 1, 1000)) },
+	)
+
 	tokenGenesis := types.NewGenesisState(
-		types.NewParams(tokenTaxRate, sdk.NewCoin(sdk.DefaultBondDenom, issueTokenBaseFee), mintTokenFeeRatio),
+		types.NewParams(tokenTaxRate, sdk.NewCoin(sdk.DefaultBondDenom, issueTokenBaseFee), mintTokenFeeRatio, pendingTransferPeriod, multiMintFeeRatio, retiredSymbolCooldown, nil, 0, bidCommitPeriod, bidRevealPeriod, symbolReservationPeriod, 0),
 		tokens,
 	)
 
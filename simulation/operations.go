@@ -181,7 +181,7 @@ func SimulateMintToken(k keeper.Keeper, ak types.AccountKeeper, bk types.BankKee
 
 		token, maxFee := selectOneToken(ctx, k, ak, bk, true)
 		simToAccount, _ := simtypes.RandomAcc(r, accs)
-		msg := types.NewMsgMintToken(token.GetSymbol(), token.GetOwner(), simToAccount.Address, 100)
+		msg := types.NewMsgMintToken(token.GetSymbol(), token.GetOwner(), simToAccount.Address, sdk.NewInt(100))
 
 		ownerAccount, found := simtypes.FindAccount(accs, token.GetOwner())
 		if !found {
@@ -364,8 +364,8 @@ func randToken(r *rand.Rand,
 		Name:          name,
 		Scale:         uint32(scale),
 		MinUnit:       strings.ToLower(minUint),
-		InitialSupply: uint64(initialSupply),
-		MaxSupply:     uint64(maxSupply),
+		InitialSupply: sdk.NewInt(initialSupply),
+		MaxSupply:     sdk.NewInt(maxSupply),
 		Mintable:      true,
 		Owner:         simAccount.Address,
 	}
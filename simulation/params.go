@@ -1,8 +1,10 @@
 package simulation
 
 import (
+	"fmt"
 	"math/rand"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
 
@@ -10,9 +12,12 @@ import (
 )
 
 const (
-	keyTokenTaxRate      = "TokenTaxRate"
-	keyIssueTokenBaseFee = "IssueTokenBaseFee"
-	keyMintTokenFeeRatio = "MintTokenFeeRatio"
+	keyTokenTaxRate          = "TokenTaxRate"
+	keyIssueTokenBaseFee     = "IssueTokenBaseFee"
+	keyMintTokenFeeRatio     = "MintTokenFeeRatio"
+	keyPendingTransferPeriod = "PendingTransferPeriod"
+	keyMultiMintFeeRatio     = "MultiMintFeeRatio"
+	keyRetiredSymbolCooldown = "RetiredSymbolCooldown"
 )
 
 // ParamChanges defines the parameters that can be modified by param change proposals
@@ -34,5 +39,20 @@ func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
 				return RandomDec(r).String()
 			},
 		),
+		simulation.NewSimParamChange(types.ModuleName, keyPendingTransferPeriod,
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", simtypes.RandIntBetween(r, 1, 1000))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, keyMultiMintFeeRatio,
+			func(r *rand.Rand) string {
+				return sdk.NewDecWithPrec(int64(r.Intn(5)), 2).String()
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, keyRetiredSymbolCooldown,
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", simtypes.RandIntBetween(r, 1, 1000))
+			},
+		),
 	}
 }
@@ -0,0 +1,36 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// BidHashLen is the length of the hex encoded hash of a sealed bid
+const BidHashLen = 2 * sha256.Size
+
+// BidHash returns the hex encoded hash which seals the bid of the bidder in the auction of the symbol.
+// The bidder is committed along with the bid so that a commitment can not be copied by another bidder
+func BidHash(symbol string, bidder sdk.AccAddress, amount sdk.Coin, salt string) string {
+	symbol = strings.ToLower(strings.TrimSpace(symbol))
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%s/%s/%s/%s", symbol, bidder, amount, salt)))
+	return hex.EncodeToString(hash[:])
+}
+
+// ValidateBidHash checks the hex encoded hash of a sealed bid, which must be in lowercase as returned by BidHash
+func ValidateBidHash(bidHash string) error {
+	if len(bidHash) != BidHashLen {
+		return sdkerrors.Wrapf(ErrInvalidBid, "the length of the bid hash must be %d", BidHashLen)
+	}
+	if _, err := hex.DecodeString(bidHash); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidBid, "the bid hash must be hex encoded: %s", err)
+	}
+	if bidHash != strings.ToLower(bidHash) {
+		return sdkerrors.Wrap(ErrInvalidBid, "the bid hash must be in lowercase")
+	}
+	return nil
+}
@@ -16,6 +16,26 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgEditToken{}, "irismod/token/MsgEditToken", nil)
 	cdc.RegisterConcrete(&MsgMintToken{}, "irismod/token/MsgMintToken", nil)
 	cdc.RegisterConcrete(&MsgTransferTokenOwner{}, "irismod/token/MsgTransferTokenOwner", nil)
+	cdc.RegisterConcrete(&MsgBurnToken{}, "irismod/token/MsgBurnToken", nil)
+	cdc.RegisterConcrete(&MsgFreezeAccount{}, "irismod/token/MsgFreezeAccount", nil)
+	cdc.RegisterConcrete(&MsgUnfreezeAccount{}, "irismod/token/MsgUnfreezeAccount", nil)
+	cdc.RegisterConcrete(&MsgPauseToken{}, "irismod/token/MsgPauseToken", nil)
+	cdc.RegisterConcrete(&MsgUnpauseToken{}, "irismod/token/MsgUnpauseToken", nil)
+	cdc.RegisterConcrete(&MsgGrantMinter{}, "irismod/token/MsgGrantMinter", nil)
+	cdc.RegisterConcrete(&MsgRevokeMinter{}, "irismod/token/MsgRevokeMinter", nil)
+	cdc.RegisterConcrete(&MsgAcceptTokenOwner{}, "irismod/token/MsgAcceptTokenOwner", nil)
+	cdc.RegisterConcrete(&MsgCancelTransferTokenOwner{}, "irismod/token/MsgCancelTransferTokenOwner", nil)
+	cdc.RegisterConcrete(&MsgGrantRole{}, "irismod/token/MsgGrantRole", nil)
+	cdc.RegisterConcrete(&MsgRevokeRole{}, "irismod/token/MsgRevokeRole", nil)
+	cdc.RegisterConcrete(&MsgMultiMint{}, "irismod/token/MsgMultiMint", nil)
+	cdc.RegisterConcrete(&MsgRetireToken{}, "irismod/token/MsgRetireToken", nil)
+	cdc.RegisterConcrete(&MsgSnapshotToken{}, "irismod/token/MsgSnapshotToken", nil)
+	cdc.RegisterConcrete(&MsgDistributeDividend{}, "irismod/token/MsgDistributeDividend", nil)
+	cdc.RegisterConcrete(&MsgClaimDividend{}, "irismod/token/MsgClaimDividend", nil)
+	cdc.RegisterConcrete(&MsgCommitBid{}, "irismod/token/MsgCommitBid", nil)
+	cdc.RegisterConcrete(&MsgRevealBid{}, "irismod/token/MsgRevealBid", nil)
+	cdc.RegisterConcrete(&MsgRenewSymbol{}, "irismod/token/MsgRenewSymbol", nil)
+	cdc.RegisterConcrete(&MsgReleaseVesting{}, "irismod/token/MsgReleaseVesting", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -24,6 +44,26 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgEditToken{},
 		&MsgMintToken{},
 		&MsgTransferTokenOwner{},
+		&MsgBurnToken{},
+		&MsgFreezeAccount{},
+		&MsgUnfreezeAccount{},
+		&MsgPauseToken{},
+		&MsgUnpauseToken{},
+		&MsgGrantMinter{},
+		&MsgRevokeMinter{},
+		&MsgAcceptTokenOwner{},
+		&MsgCancelTransferTokenOwner{},
+		&MsgGrantRole{},
+		&MsgRevokeRole{},
+		&MsgMultiMint{},
+		&MsgRetireToken{},
+		&MsgSnapshotToken{},
+		&MsgDistributeDividend{},
+		&MsgClaimDividend{},
+		&MsgCommitBid{},
+		&MsgRevealBid{},
+		&MsgRenewSymbol{},
+		&MsgReleaseVesting{},
 	)
 	registry.RegisterInterface(
 		"irismod.token.TokenI",
@@ -21,4 +21,35 @@ var (
 	ErrInvalidToAddress     = sdkerrors.Register(ModuleName, 13, "the new owner must not be same as the original owner")
 	ErrInvalidOwner         = sdkerrors.Register(ModuleName, 14, "invalid token owner")
 	ErrNotMintable          = sdkerrors.Register(ModuleName, 15, "the token is set to be non-mintable")
+	ErrInvalidAmount        = sdkerrors.Register(ModuleName, 16, "invalid amount")
+	ErrAccountFrozen        = sdkerrors.Register(ModuleName, 17, "the account is frozen for the token")
+	ErrAccountNotFrozen     = sdkerrors.Register(ModuleName, 18, "the account is not frozen for the token")
+	ErrTokenPaused          = sdkerrors.Register(ModuleName, 19, "the token is paused")
+	ErrTokenNotPaused       = sdkerrors.Register(ModuleName, 20, "the token is not paused")
+	ErrInvalidMinter        = sdkerrors.Register(ModuleName, 21, "invalid minter")
+	ErrMinterNotExists      = sdkerrors.Register(ModuleName, 22, "minter does not exist")
+	ErrMinterExpired        = sdkerrors.Register(ModuleName, 23, "the minter has expired")
+	ErrInvalidExpiry        = sdkerrors.Register(ModuleName, 24, "invalid expiry")
+	ErrTransferNotExists    = sdkerrors.Register(ModuleName, 25, "pending transfer does not exist")
+	ErrInvalidRole          = sdkerrors.Register(ModuleName, 26, "invalid role")
+	ErrRoleNotExists        = sdkerrors.Register(ModuleName, 27, "role does not exist")
+	ErrInvalidDescription   = sdkerrors.Register(ModuleName, 28, "invalid token description")
+	ErrInvalidWebsite       = sdkerrors.Register(ModuleName, 29, "invalid token website")
+	ErrInvalidLogoURI       = sdkerrors.Register(ModuleName, 30, "invalid token logo uri")
+	ErrInvalidContentHash   = sdkerrors.Register(ModuleName, 31, "invalid token content hash")
+	ErrInvalidAllocation    = sdkerrors.Register(ModuleName, 32, "invalid vesting allocation")
+	ErrSymbolRetired        = sdkerrors.Register(ModuleName, 33, "the symbol is retired")
+	ErrNonZeroSupply        = sdkerrors.Register(ModuleName, 34, "the supply of the token is not zero")
+	ErrSnapshotNotExists    = sdkerrors.Register(ModuleName, 35, "snapshot does not exist")
+	ErrInvalidDividend      = sdkerrors.Register(ModuleName, 36, "invalid dividend")
+	ErrNoDividend           = sdkerrors.Register(ModuleName, 37, "no dividend to claim")
+	ErrInvalidFeeDenom      = sdkerrors.Register(ModuleName, 38, "invalid fee denom")
+	ErrInvalidBid           = sdkerrors.Register(ModuleName, 39, "invalid bid")
+	ErrAuctionNotExists     = sdkerrors.Register(ModuleName, 40, "auction does not exist")
+	ErrSymbolReserved       = sdkerrors.Register(ModuleName, 41, "the symbol is reserved")
+	ErrInvalidLease         = sdkerrors.Register(ModuleName, 42, "invalid symbol lease")
+	ErrTokenLapsed          = sdkerrors.Register(ModuleName, 43, "the symbol lease of the token has lapsed")
+	ErrInvalidStoreVersion  = sdkerrors.Register(ModuleName, 44, "invalid store version")
+	ErrInvalidTokenHistory  = sdkerrors.Register(ModuleName, 45, "invalid token history")
+	ErrHoldersNotIndexed    = sdkerrors.Register(ModuleName, 46, "the holders of the token are not indexed")
 )
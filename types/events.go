@@ -8,7 +8,46 @@ const (
 	EventTypeEditToken          = "edit_token"
 	EventTypeMintToken          = "mint_token"
 	EventTypeTransferTokenOwner = "transfer_token_owner"
+	EventTypeBurnToken          = "burn_token"
+	EventTypeFreezeAccount      = "freeze_account"
+	EventTypeUnfreezeAccount    = "unfreeze_account"
+	EventTypePauseToken         = "pause_token"
+	EventTypeUnpauseToken       = "unpause_token"
+	EventTypeGrantMinter        = "grant_minter"
+	EventTypeRevokeMinter       = "revoke_minter"
 
-	AttributeKeySymbol = "symbol"
-	AttributeKeyAmount = "amount"
+	EventTypeAcceptTokenOwner         = "accept_token_owner"
+	EventTypeCancelTransferTokenOwner = "cancel_transfer_token_owner"
+	EventTypeExpireTransferTokenOwner = "expire_transfer_token_owner"
+	EventTypeGrantRole                = "grant_role"
+	EventTypeRevokeRole               = "revoke_role"
+	EventTypeReleaseVesting           = "release_vesting"
+	EventTypeMultiMintToken           = "multi_mint_token"
+	EventTypeRetireToken              = "retire_token"
+	EventTypeSnapshotToken            = "snapshot_token"
+	EventTypeDistributeDividend       = "distribute_dividend"
+	EventTypeClaimDividend            = "claim_dividend"
+	EventTypeCommitBid                = "commit_bid"
+	EventTypeRevealBid                = "reveal_bid"
+	EventTypeSettleAuction            = "settle_auction"
+	EventTypeRenewSymbol              = "renew_symbol"
+	EventTypeLapseToken               = "lapse_token"
+	EventTypeDeductTokenFee           = "deduct_token_fee"
+
+	AttributeKeySymbol    = "symbol"
+	AttributeKeyAmount    = "amount"
+	AttributeKeyAccount   = "account"
+	AttributeKeyMinter    = "minter"
+	AttributeKeyQuota     = "quota"
+	AttributeKeySrcOwner  = "src_owner"
+	AttributeKeyDstOwner  = "dst_owner"
+	AttributeKeyRole      = "role"
+	AttributeKeyRecipient = "recipient"
+	AttributeKeyTotal     = "total_amount"
+	AttributeKeySnapshot  = "snapshot_id"
+	AttributeKeyBidder    = "bidder"
+	AttributeKeyWinner    = "winner"
+	AttributeKeyOwner     = "owner"
+	AttributeKeyExpire    = "expire_height"
+	AttributeKeyPayer     = "payer"
 )
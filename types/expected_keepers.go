@@ -13,6 +13,8 @@ type BankKeeper interface {
 
 	GetSupply(ctx sdk.Context) (supply bank.SupplyI)
 	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	IterateAllBalances(ctx sdk.Context, cb func(address sdk.AccAddress, coin sdk.Coin) (stop bool))
 
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
@@ -27,3 +29,12 @@ type AccountKeeper interface {
 	GetModuleAddress(name string) sdk.AccAddress
 	GetModuleAccount(ctx sdk.Context, name string) authtypes.ModuleAccountI
 }
+
+// TokenHooks defines the hooks called after the token lifecycle events.
+// An error returned by a hook aborts the message
+type TokenHooks interface {
+	AfterTokenIssued(ctx sdk.Context, token Token) error
+	AfterTokenMinted(ctx sdk.Context, symbol string, recipient sdk.AccAddress, amount sdk.Coin) error
+	AfterTokenEdited(ctx sdk.Context, token Token, changes []TokenChange) error
+	AfterTokenOwnerChanged(ctx sdk.Context, symbol string, srcOwner, dstOwner sdk.AccAddress) error
+}
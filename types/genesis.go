@@ -20,7 +20,7 @@ func SetNativeToken(symbol,
 	minUnit string,
 	decimal uint32,
 	initialSupply,
-	maxSupply uint64,
+	maxSupply sdk.Int,
 	mintable bool,
 	owner sdk.AccAddress) {
 	nativeToken = NewToken(symbol, name, minUnit, decimal, initialSupply, maxSupply, mintable, owner)
@@ -35,8 +35,8 @@ var nativeToken = Token{
 	Name:          "Network staking token",
 	Scale:         0,
 	MinUnit:       sdk.DefaultBondDenom,
-	InitialSupply: 2000000000,
-	MaxSupply:     10000000000,
+	InitialSupply: sdk.NewInt(2000000000),
+	MaxSupply:     sdk.NewInt(10000000000),
 	Mintable:      true,
 	Owner:         sdk.AccAddress(crypto.AddressHash([]byte(ModuleName))),
 }
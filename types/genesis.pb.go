@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -25,8 +26,23 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the token module's genesis state.
 type GenesisState struct {
-	Params Params  `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	Tokens []Token `protobuf:"bytes,2,rep,name=tokens,proto3" json:"tokens"`
+	Params              Params               `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	Tokens              []Token              `protobuf:"bytes,2,rep,name=tokens,proto3" json:"tokens"`
+	BurnedCoins         []types.Coin         `protobuf:"bytes,3,rep,name=burned_coins,json=burnedCoins,proto3" json:"burned_coins" yaml:"burned_coins"`
+	FrozenAccounts      []FrozenAccount      `protobuf:"bytes,4,rep,name=frozen_accounts,json=frozenAccounts,proto3" json:"frozen_accounts" yaml:"frozen_accounts"`
+	Minters             []Minter             `protobuf:"bytes,5,rep,name=minters,proto3" json:"minters"`
+	PendingTransfers    []PendingTransfer    `protobuf:"bytes,6,rep,name=pending_transfers,json=pendingTransfers,proto3" json:"pending_transfers" yaml:"pending_transfers"`
+	Roles               []RoleGrant          `protobuf:"bytes,7,rep,name=roles,proto3" json:"roles"`
+	VestingSchedules    []VestingSchedule    `protobuf:"bytes,8,rep,name=vesting_schedules,json=vestingSchedules,proto3" json:"vesting_schedules" yaml:"vesting_schedules"`
+	RetiredTokens       []RetiredToken       `protobuf:"bytes,9,rep,name=retired_tokens,json=retiredTokens,proto3" json:"retired_tokens" yaml:"retired_tokens"`
+	Snapshots           []Snapshot           `protobuf:"bytes,10,rep,name=snapshots,proto3" json:"snapshots"`
+	SnapshotBalances    []SnapshotBalance    `protobuf:"bytes,11,rep,name=snapshot_balances,json=snapshotBalances,proto3" json:"snapshot_balances" yaml:"snapshot_balances"`
+	DividendIndexes     []DividendIndex      `protobuf:"bytes,12,rep,name=dividend_indexes,json=dividendIndexes,proto3" json:"dividend_indexes" yaml:"dividend_indexes"`
+	DividendCheckpoints []DividendCheckpoint `protobuf:"bytes,13,rep,name=dividend_checkpoints,json=dividendCheckpoints,proto3" json:"dividend_checkpoints" yaml:"dividend_checkpoints"`
+	Auctions            []Auction            `protobuf:"bytes,14,rep,name=auctions,proto3" json:"auctions"`
+	Bids                []Bid                `protobuf:"bytes,15,rep,name=bids,proto3" json:"bids"`
+	SymbolReservations  []SymbolReservation  `protobuf:"bytes,16,rep,name=symbol_reservations,json=symbolReservations,proto3" json:"symbol_reservations" yaml:"symbol_reservations"`
+	TokenHistory        []TokenHistoryEntry  `protobuf:"bytes,17,rep,name=token_history,json=tokenHistory,proto3" json:"token_history" yaml:"token_history"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -76,6 +92,111 @@ func (m *GenesisState) GetTokens() []Token {
 	return nil
 }
 
+func (m *GenesisState) GetBurnedCoins() []types.Coin {
+	if m != nil {
+		return m.BurnedCoins
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFrozenAccounts() []FrozenAccount {
+	if m != nil {
+		return m.FrozenAccounts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMinters() []Minter {
+	if m != nil {
+		return m.Minters
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingTransfers() []PendingTransfer {
+	if m != nil {
+		return m.PendingTransfers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRoles() []RoleGrant {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVestingSchedules() []VestingSchedule {
+	if m != nil {
+		return m.VestingSchedules
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRetiredTokens() []RetiredToken {
+	if m != nil {
+		return m.RetiredTokens
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSnapshots() []Snapshot {
+	if m != nil {
+		return m.Snapshots
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSnapshotBalances() []SnapshotBalance {
+	if m != nil {
+		return m.SnapshotBalances
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDividendIndexes() []DividendIndex {
+	if m != nil {
+		return m.DividendIndexes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDividendCheckpoints() []DividendCheckpoint {
+	if m != nil {
+		return m.DividendCheckpoints
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAuctions() []Auction {
+	if m != nil {
+		return m.Auctions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBids() []Bid {
+	if m != nil {
+		return m.Bids
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSymbolReservations() []SymbolReservation {
+	if m != nil {
+		return m.SymbolReservations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTokenHistory() []TokenHistoryEntry {
+	if m != nil {
+		return m.TokenHistory
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "irismod.token.GenesisState")
 }
@@ -83,20 +204,53 @@ func init() {
 func init() { proto.RegisterFile("genesis.proto", fileDescriptor_14205810582f3203) }
 
 var fileDescriptor_14205810582f3203 = []byte{
-	// 199 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x4d, 0x4f, 0xcd, 0x4b,
-	0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0xcd, 0x2c, 0xca, 0x2c, 0xce,
-	0xcd, 0x4f, 0xd1, 0x2b, 0xc9, 0xcf, 0x4e, 0xcd, 0x93, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xcb,
-	0xe8, 0x83, 0x58, 0x10, 0x45, 0x52, 0xdc, 0x60, 0x49, 0x08, 0x47, 0xa9, 0x9c, 0x8b, 0xc7, 0x1d,
-	0x62, 0x44, 0x70, 0x49, 0x62, 0x49, 0xaa, 0x90, 0x31, 0x17, 0x5b, 0x41, 0x62, 0x51, 0x62, 0x6e,
-	0xb1, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0xa8, 0x1e, 0x8a, 0x91, 0x7a, 0x01, 0x60, 0x49,
-	0x27, 0x96, 0x13, 0xf7, 0xe4, 0x19, 0x82, 0xa0, 0x4a, 0x85, 0x8c, 0xb8, 0xd8, 0xc0, 0xb2, 0xc5,
-	0x12, 0x4c, 0x0a, 0xcc, 0x1a, 0xdc, 0x46, 0x22, 0x68, 0x9a, 0x42, 0x40, 0x24, 0x4c, 0x0f, 0x44,
-	0xa5, 0x93, 0xc5, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38,
-	0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0xc9, 0xa5, 0x67,
-	0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x43, 0xcd, 0xd1, 0x07, 0x6b, 0xd2, 0x2f,
-	0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0xbb, 0xdc, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x8f,
-	0xda, 0x1e, 0xb1, 0xfc, 0x00, 0x00, 0x00,
+	// 721 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0x4f, 0x4f, 0x1b, 0x39,
+	0x18, 0xc6, 0x93, 0x05, 0x02, 0x38, 0x09, 0x04, 0x13, 0x60, 0x16, 0xd8, 0x21, 0x3b, 0x7b, 0xe1,
+	0xb0, 0x9a, 0x08, 0xd8, 0x95, 0xd0, 0xee, 0x89, 0xa1, 0x2d, 0xed, 0xa1, 0x52, 0x35, 0xa0, 0x4a,
+	0xed, 0x65, 0x34, 0x7f, 0x4c, 0x62, 0x91, 0xb1, 0x23, 0xbf, 0x4e, 0xd4, 0xf4, 0x53, 0xf4, 0x03,
+	0xf5, 0x03, 0x70, 0xe4, 0xd8, 0x13, 0xaa, 0xe0, 0x1b, 0xf4, 0x13, 0x54, 0x63, 0x9b, 0x90, 0x38,
+	0xe9, 0x25, 0x8a, 0xfd, 0xfe, 0x9e, 0xe7, 0x79, 0xfd, 0xda, 0x09, 0xaa, 0x77, 0x08, 0x23, 0x40,
+	0xc1, 0xef, 0x0b, 0x2e, 0x39, 0xae, 0x53, 0x41, 0x21, 0xe7, 0x99, 0x2f, 0xf9, 0x0d, 0x61, 0xbb,
+	0x3b, 0x29, 0x87, 0x9c, 0x43, 0xa4, 0x8a, 0xed, 0x94, 0x53, 0xa6, 0xb9, 0xdd, 0x66, 0x87, 0x77,
+	0xb8, 0xde, 0x2d, 0xbe, 0x99, 0xdd, 0xaa, 0x52, 0xe9, 0x85, 0xf7, 0xb5, 0x8a, 0x6a, 0x17, 0xda,
+	0xfc, 0x52, 0xc6, 0x92, 0xe0, 0x13, 0x54, 0xe9, 0xc7, 0x22, 0xce, 0xc1, 0x29, 0xb7, 0xca, 0x87,
+	0xd5, 0xe3, 0x2d, 0x7f, 0x2a, 0xcc, 0x7f, 0xa7, 0x8a, 0xc1, 0xe2, 0xed, 0xfd, 0x41, 0x29, 0x34,
+	0x28, 0x3e, 0x46, 0x15, 0x55, 0x05, 0xe7, 0xb7, 0xd6, 0xc2, 0x61, 0xf5, 0xb8, 0x69, 0x89, 0xae,
+	0x8a, 0xcf, 0x27, 0x8d, 0x26, 0xf1, 0x07, 0x54, 0x4b, 0x06, 0x82, 0x91, 0x2c, 0x2a, 0x3a, 0x06,
+	0x67, 0x41, 0x29, 0x7f, 0xf7, 0xf5, 0x61, 0xfc, 0x24, 0x06, 0xe2, 0x0f, 0x8f, 0x12, 0x22, 0xe3,
+	0x23, 0xff, 0x9c, 0x53, 0x16, 0xec, 0x15, 0xf2, 0x1f, 0xf7, 0x07, 0x9b, 0xa3, 0x38, 0xef, 0xfd,
+	0xe7, 0x4d, 0x8a, 0xbd, 0xb0, 0xaa, 0x97, 0x05, 0x08, 0x98, 0xa0, 0xf5, 0x6b, 0xc1, 0x3f, 0x13,
+	0x16, 0xc5, 0x69, 0xca, 0x07, 0x4c, 0x82, 0xb3, 0xa8, 0xdc, 0xf7, 0xad, 0xbe, 0x5e, 0x29, 0xea,
+	0x4c, 0x43, 0x81, 0x6b, 0x02, 0xb6, 0x75, 0x80, 0x65, 0xe1, 0x85, 0x6b, 0xd7, 0x93, 0x38, 0xe0,
+	0x7f, 0xd1, 0x72, 0x4e, 0x99, 0x24, 0x02, 0x9c, 0xa5, 0xd6, 0xc2, 0x9c, 0x59, 0xbd, 0x55, 0x55,
+	0x73, 0xee, 0x27, 0x16, 0xe7, 0x68, 0xa3, 0x4f, 0x58, 0x46, 0x59, 0x27, 0x92, 0x22, 0x66, 0x70,
+	0x5d, 0x18, 0x54, 0x94, 0x81, 0x6b, 0x0f, 0x5b, 0x73, 0x57, 0x06, 0x0b, 0x5a, 0xa6, 0x43, 0x47,
+	0x77, 0x38, 0x63, 0xe3, 0x85, 0x8d, 0xfe, 0xb4, 0x04, 0xf0, 0x3f, 0x68, 0x49, 0xf0, 0x1e, 0x01,
+	0x67, 0x59, 0x45, 0x38, 0x56, 0x44, 0xc8, 0x7b, 0xe4, 0x42, 0xc4, 0x4c, 0x9a, 0x36, 0x35, 0x5c,
+	0x34, 0x39, 0x24, 0x20, 0x0b, 0x77, 0x48, 0xbb, 0x24, 0x1b, 0x14, 0x0e, 0x2b, 0x73, 0x9b, 0x7c,
+	0xaf, 0xb9, 0x4b, 0x83, 0xd9, 0x4d, 0xce, 0xd8, 0x78, 0x61, 0x63, 0x38, 0x2d, 0x01, 0x1c, 0xa3,
+	0x35, 0x41, 0x24, 0x15, 0x24, 0x8b, 0xcc, 0x43, 0x5a, 0x55, 0x59, 0x7b, 0x76, 0xb7, 0x1a, 0xd2,
+	0xef, 0xe9, 0x0f, 0x13, 0xb4, 0xa5, 0x83, 0xa6, 0x0d, 0xbc, 0xb0, 0x2e, 0x26, 0x60, 0xc0, 0xff,
+	0xa3, 0x55, 0x60, 0x71, 0x1f, 0xba, 0x5c, 0x82, 0x83, 0x94, 0xfb, 0x8e, 0xe5, 0x7e, 0x69, 0xea,
+	0x66, 0x14, 0xcf, 0x7c, 0x31, 0x8e, 0xa7, 0x45, 0x94, 0xc4, 0xbd, 0x98, 0xa5, 0x04, 0x9c, 0xea,
+	0xdc, 0x71, 0x8c, 0x4d, 0x34, 0x66, 0x8f, 0x63, 0xc6, 0xc6, 0x0b, 0x1b, 0x30, 0x2d, 0x01, 0xdc,
+	0x45, 0x8d, 0x8c, 0x0e, 0x69, 0x46, 0x58, 0x16, 0x51, 0x96, 0x91, 0x4f, 0x04, 0x9c, 0xda, 0xdc,
+	0x17, 0xfc, 0xc2, 0x60, 0x6f, 0x0a, 0x2a, 0x38, 0x30, 0x59, 0x3b, 0x3a, 0xcb, 0xf6, 0xf0, 0xc2,
+	0xf5, 0x6c, 0x92, 0x27, 0x80, 0x47, 0xa8, 0x39, 0xa6, 0xd2, 0x2e, 0x49, 0x6f, 0xfa, 0x9c, 0x16,
+	0xbf, 0x97, 0xba, 0x4a, 0xfb, 0xf3, 0x17, 0x69, 0xe7, 0x63, 0x32, 0xf8, 0xcb, 0x44, 0xee, 0x59,
+	0x91, 0x13, 0x66, 0x5e, 0xb8, 0x99, 0xcd, 0x08, 0x01, 0x9f, 0xa2, 0x95, 0x78, 0x90, 0x4a, 0xca,
+	0x19, 0x38, 0x6b, 0x2a, 0x6e, 0xdb, 0x8a, 0x3b, 0xd3, 0x65, 0x73, 0x1d, 0x63, 0x1a, 0xff, 0x8d,
+	0x16, 0x13, 0x9a, 0x81, 0xb3, 0xae, 0x54, 0xd8, 0x52, 0x05, 0x34, 0x33, 0x0a, 0x45, 0xe1, 0x01,
+	0xda, 0x84, 0x51, 0x9e, 0xf0, 0x5e, 0x24, 0x08, 0x10, 0x31, 0x8c, 0x75, 0x64, 0x43, 0x89, 0x5b,
+	0xf6, 0xed, 0x29, 0x32, 0x7c, 0x06, 0x03, 0xcf, 0x1c, 0x70, 0xd7, 0xdc, 0xdf, 0xac, 0x95, 0x17,
+	0x62, 0xb0, 0x65, 0x80, 0x53, 0x54, 0x57, 0x96, 0x51, 0x97, 0x82, 0xe4, 0x62, 0xe4, 0x6c, 0xcc,
+	0x0d, 0x54, 0xaf, 0xf3, 0xb5, 0x46, 0x5e, 0x32, 0x29, 0x46, 0xc1, 0xbe, 0x09, 0x6c, 0xea, 0xc0,
+	0x29, 0x13, 0x2f, 0xac, 0xc9, 0x09, 0x41, 0x70, 0x7a, 0xfb, 0xe0, 0x96, 0xef, 0x1e, 0xdc, 0xf2,
+	0xf7, 0x07, 0xb7, 0xfc, 0xe5, 0xd1, 0x2d, 0xdd, 0x3d, 0xba, 0xa5, 0x6f, 0x8f, 0x6e, 0xe9, 0xa3,
+	0xdb, 0xa1, 0xb2, 0x3b, 0x48, 0xfc, 0x94, 0xe7, 0x6d, 0x93, 0xd8, 0x56, 0xd2, 0xb6, 0x1c, 0xf5,
+	0x09, 0x24, 0x15, 0xf5, 0xff, 0x7f, 0xf2, 0x73, 0x00, 0x0f, 0x39, 0x44, 0xda, 0x5b, 0x06, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -119,6 +273,220 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TokenHistory) > 0 {
+		for iNdEx := len(m.TokenHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.SymbolReservations) > 0 {
+		for iNdEx := len(m.SymbolReservations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SymbolReservations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.Bids) > 0 {
+		for iNdEx := len(m.Bids) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bids[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.Auctions) > 0 {
+		for iNdEx := len(m.Auctions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Auctions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.DividendCheckpoints) > 0 {
+		for iNdEx := len(m.DividendCheckpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DividendCheckpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.DividendIndexes) > 0 {
+		for iNdEx := len(m.DividendIndexes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DividendIndexes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.SnapshotBalances) > 0 {
+		for iNdEx := len(m.SnapshotBalances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SnapshotBalances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.Snapshots) > 0 {
+		for iNdEx := len(m.Snapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Snapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.RetiredTokens) > 0 {
+		for iNdEx := len(m.RetiredTokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RetiredTokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.VestingSchedules) > 0 {
+		for iNdEx := len(m.VestingSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Roles) > 0 {
+		for iNdEx := len(m.Roles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Roles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.PendingTransfers) > 0 {
+		for iNdEx := len(m.PendingTransfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingTransfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Minters) > 0 {
+		for iNdEx := len(m.Minters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Minters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.FrozenAccounts) > 0 {
+		for iNdEx := len(m.FrozenAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FrozenAccounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.BurnedCoins) > 0 {
+		for iNdEx := len(m.BurnedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BurnedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Tokens) > 0 {
 		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -171,35 +539,125 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
-	return n
-}
-
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
+	if len(m.BurnedCoins) > 0 {
+		for _, e := range m.BurnedCoins {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FrozenAccounts) > 0 {
+		for _, e := range m.FrozenAccounts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Minters) > 0 {
+		for _, e := range m.Minters {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingTransfers) > 0 {
+		for _, e := range m.PendingTransfers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Roles) > 0 {
+		for _, e := range m.Roles {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VestingSchedules) > 0 {
+		for _, e := range m.VestingSchedules {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RetiredTokens) > 0 {
+		for _, e := range m.RetiredTokens {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Snapshots) > 0 {
+		for _, e := range m.Snapshots {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SnapshotBalances) > 0 {
+		for _, e := range m.SnapshotBalances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DividendIndexes) > 0 {
+		for _, e := range m.DividendIndexes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DividendCheckpoints) > 0 {
+		for _, e := range m.DividendCheckpoints {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Auctions) > 0 {
+		for _, e := range m.Auctions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Bids) > 0 {
+		for _, e := range m.Bids {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SymbolReservations) > 0 {
+		for _, e := range m.SymbolReservations {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TokenHistory) > 0 {
+		for _, e := range m.TokenHistory {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
@@ -276,6 +734,516 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BurnedCoins = append(m.BurnedCoins, types.Coin{})
+			if err := m.BurnedCoins[len(m.BurnedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenAccounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrozenAccounts = append(m.FrozenAccounts, FrozenAccount{})
+			if err := m.FrozenAccounts[len(m.FrozenAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minters = append(m.Minters, Minter{})
+			if err := m.Minters[len(m.Minters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingTransfers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingTransfers = append(m.PendingTransfers, PendingTransfer{})
+			if err := m.PendingTransfers[len(m.PendingTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Roles = append(m.Roles, RoleGrant{})
+			if err := m.Roles[len(m.Roles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingSchedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingSchedules = append(m.VestingSchedules, VestingSchedule{})
+			if err := m.VestingSchedules[len(m.VestingSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetiredTokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetiredTokens = append(m.RetiredTokens, RetiredToken{})
+			if err := m.RetiredTokens[len(m.RetiredTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Snapshots = append(m.Snapshots, Snapshot{})
+			if err := m.Snapshots[len(m.Snapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotBalances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SnapshotBalances = append(m.SnapshotBalances, SnapshotBalance{})
+			if err := m.SnapshotBalances[len(m.SnapshotBalances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DividendIndexes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DividendIndexes = append(m.DividendIndexes, DividendIndex{})
+			if err := m.DividendIndexes[len(m.DividendIndexes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DividendCheckpoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DividendCheckpoints = append(m.DividendCheckpoints, DividendCheckpoint{})
+			if err := m.DividendCheckpoints[len(m.DividendCheckpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auctions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Auctions = append(m.Auctions, Auction{})
+			if err := m.Auctions[len(m.Auctions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bids", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bids = append(m.Bids, Bid{})
+			if err := m.Bids[len(m.Bids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SymbolReservations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SymbolReservations = append(m.SymbolReservations, SymbolReservation{})
+			if err := m.SymbolReservations[len(m.SymbolReservations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenHistory = append(m.TokenHistory, TokenHistoryEntry{})
+			if err := m.TokenHistory[len(m.TokenHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
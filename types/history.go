@@ -0,0 +1,25 @@
+package types
+
+import "strconv"
+
+// TokenChanges returns the changes of the mutable fields from the old token to the updated one
+func TokenChanges(old, updated Token) (changes []TokenChange) {
+	addChange := func(field, oldValue, newValue string) {
+		if oldValue != newValue {
+			changes = append(changes, TokenChange{Field: field, OldValue: oldValue, NewValue: newValue})
+		}
+	}
+
+	addChange("name", old.Name, updated.Name)
+	addChange("max_supply", old.MaxSupply.String(), updated.MaxSupply.String())
+	addChange("mintable", strconv.FormatBool(old.Mintable), strconv.FormatBool(updated.Mintable))
+	addChange("owner", old.Owner.String(), updated.Owner.String())
+	addChange("paused", strconv.FormatBool(old.Paused), strconv.FormatBool(updated.Paused))
+	addChange("description", old.Description, updated.Description)
+	addChange("website", old.Website, updated.Website)
+	addChange("logo_uri", old.LogoURI, updated.LogoURI)
+	addChange("content_hash", old.ContentHash, updated.ContentHash)
+	addChange("lease_expire_height", strconv.FormatInt(old.LeaseExpireHeight, 10), strconv.FormatInt(updated.LeaseExpireHeight, 10))
+	addChange("lapsed", strconv.FormatBool(old.Lapsed), strconv.FormatBool(updated.Lapsed))
+	return
+}
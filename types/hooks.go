@@ -0,0 +1,53 @@
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+var _ TokenHooks = MultiTokenHooks{}
+
+// MultiTokenHooks combines multiple token hooks, which are called in order until one of them fails
+type MultiTokenHooks []TokenHooks
+
+// NewMultiTokenHooks returns the combination of the token hooks
+func NewMultiTokenHooks(hooks ...TokenHooks) MultiTokenHooks {
+	return hooks
+}
+
+// AfterTokenIssued implements TokenHooks
+func (h MultiTokenHooks) AfterTokenIssued(ctx sdk.Context, token Token) error {
+	for _, hook := range h {
+		if err := hook.AfterTokenIssued(ctx, token); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AfterTokenMinted implements TokenHooks
+func (h MultiTokenHooks) AfterTokenMinted(ctx sdk.Context, symbol string, recipient sdk.AccAddress, amount sdk.Coin) error {
+	for _, hook := range h {
+		if err := hook.AfterTokenMinted(ctx, symbol, recipient, amount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AfterTokenEdited implements TokenHooks
+func (h MultiTokenHooks) AfterTokenEdited(ctx sdk.Context, token Token, changes []TokenChange) error {
+	for _, hook := range h {
+		if err := hook.AfterTokenEdited(ctx, token, changes); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AfterTokenOwnerChanged implements TokenHooks
+func (h MultiTokenHooks) AfterTokenOwnerChanged(ctx sdk.Context, symbol string, srcOwner, dstOwner sdk.AccAddress) error {
+	for _, hook := range h {
+		if err := hook.AfterTokenOwnerChanged(ctx, symbol, srcOwner, dstOwner); err != nil {
+			return err
+		}
+	}
+	return nil
+}
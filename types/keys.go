@@ -2,6 +2,7 @@ package types
 
 import (
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -21,12 +22,48 @@ const (
 
 	// DefaultParamspace default name for parameter store
 	DefaultParamspace = ModuleName
+
+	// ConsensusVersion defines the current version of the store layout of the token module
+	ConsensusVersion uint64 = 2
+
+	// balanceLen is the length of the balances encoded in the keys, which are at most 256 bits
+	balanceLen = 32
 )
 
 var (
 	PrefixTokenForSymbol  = []byte{0x1} // symbol prefix for the token
 	PrefixTokenForMinUint = []byte{0x2} // min_unit prefix for the token
 	PrefixTokens          = []byte{0x3} // prefix for the tokens
+	PrefixBurnTokenAmt    = []byte{0x4} // prefix for the amount of token burnt
+	PrefixFrozenAccounts  = []byte{0x5} // prefix for the accounts frozen for the token
+	PrefixMinters         = []byte{0x6} // prefix for the minters of the token
+
+	PrefixPendingTransfers            = []byte{0x7}  // prefix for the pending transfers of the token owner
+	PrefixPendingTransfersByRecipient = []byte{0x8}  // prefix for the pending transfers indexed by the new owner
+	PrefixPendingTransferQueue        = []byte{0x9}  // prefix for the pending transfers indexed by the expiration height
+	PrefixRoles                       = []byte{0xA}  // prefix for the roles granted for the token
+	PrefixVestingSchedules            = []byte{0xB}  // prefix for the vesting schedules of the initial supply
+	PrefixRetiredTokens               = []byte{0xC}  // prefix for the tombstones of the retired tokens
+	PrefixRetiredMinUnits             = []byte{0xD}  // prefix for the tombstones of the retired tokens indexed by min_unit
+	PrefixSnapshots                   = []byte{0xE}  // prefix for the snapshots of the token
+	PrefixSnapshotBalances            = []byte{0xF}  // prefix for the balances of the holders recorded for the snapshots
+	PrefixDividendIndexes             = []byte{0x10} // prefix for the cumulative dividends per unit of the token
+	PrefixDividendCheckpoints         = []byte{0x11} // prefix for the dividend checkpoints of the token holders
+	PrefixAuctions                    = []byte{0x12} // prefix for the auctions of the short symbols
+	PrefixBids                        = []byte{0x13} // prefix for the sealed bids in the auctions
+	PrefixAuctionQueue                = []byte{0x14} // prefix for the auctions indexed by the height at which the reveal period ends
+	PrefixSymbolReservations          = []byte{0x15} // prefix for the symbols reserved for the auction winners
+	PrefixSymbolReservationQueue      = []byte{0x16} // prefix for the symbol reservations indexed by the expiration height
+	PrefixLeaseQueue                  = []byte{0x17} // prefix for the symbol leases indexed by the expiration height
+	KeyStoreVersion                   = []byte{0x18} // key for the version of the store layout
+	PrefixHolders                     = []byte{0x19} // prefix for the balances of the token holders
+	PrefixHoldersByBalance            = []byte{0x1A} // prefix for the token holders indexed by the balance
+	PrefixHolderCounts                = []byte{0x1B} // prefix for the numbers of the token holders
+	PrefixTokenHistory                = []byte{0x1C} // prefix for the recorded mutations of the token
+	PrefixVestingQueue                = []byte{0x1D} // prefix for the vesting schedules indexed by the end time
+	PrefixRetiredTokenHistory         = []byte{0x1E} // prefix for the recorded mutations of the retired tokens
+
+	Delimiter = []byte("/") // separator between a symbol and the rest of a key
 )
 
 // KeySymbol returns the key of the token with the specified symbol
@@ -46,3 +83,263 @@ func KeyTokens(owner sdk.AccAddress, symbol string) []byte {
 	symbol = strings.ToLower(strings.TrimSpace(symbol))
 	return append(append(PrefixTokens, owner.Bytes()...), []byte(symbol)...)
 }
+
+// KeyBurnTokenAmt returns the key of the burned amount of the token with the specified min_unit
+func KeyBurnTokenAmt(minUnit string) []byte {
+	minUnit = strings.ToLower(strings.TrimSpace(minUnit))
+	return append(PrefixBurnTokenAmt, []byte(minUnit)...)
+}
+
+// KeyFrozenAccount returns the key of the specified symbol and frozen account
+func KeyFrozenAccount(symbol string, addr sdk.AccAddress) []byte {
+	return append(KeyFrozenAccounts(symbol), addr.Bytes()...)
+}
+
+// KeyFrozenAccounts returns the key prefix of the accounts frozen for the specified symbol
+func KeyFrozenAccounts(symbol string) []byte {
+	symbol = strings.ToLower(strings.TrimSpace(symbol))
+	return append(append(PrefixFrozenAccounts, []byte(symbol)...), Delimiter...)
+}
+
+// KeyMinter returns the key of the specified symbol and minter
+func KeyMinter(symbol string, minter sdk.AccAddress) []byte {
+	return append(KeyMinters(symbol), minter.Bytes()...)
+}
+
+// KeyMinters returns the key prefix of the minters of the specified symbol
+func KeyMinters(symbol string) []byte {
+	symbol = strings.ToLower(strings.TrimSpace(symbol))
+	return append(append(PrefixMinters, []byte(symbol)...), Delimiter...)
+}
+
+// KeyRole returns the key of the specified role granted to the address for the symbol
+func KeyRole(symbol string, addr sdk.AccAddress, role Role) []byte {
+	return append(append(KeyRoles(symbol), addr.Bytes()...), byte(role))
+}
+
+// KeyRoles returns the key prefix of the roles granted for the specified symbol
+func KeyRoles(symbol string) []byte {
+	symbol = strings.ToLower(strings.TrimSpace(symbol))
+	return append(append(PrefixRoles, []byte(symbol)...), Delimiter...)
+}
+
+// KeyVestingSchedule returns the key of the vesting schedule of the specified symbol and recipient
+func KeyVestingSchedule(symbol string, recipient sdk.AccAddress) []byte {
+	return append(KeyVestingSchedules(symbol), recipient.Bytes()...)
+}
+
+// KeyVestingSchedules returns the key prefix of the vesting schedules of the specified symbol
+func KeyVestingSchedules(symbol string) []byte {
+	symbol = strings.ToLower(strings.TrimSpace(symbol))
+	return append(append(PrefixVestingSchedules, []byte(symbol)...), Delimiter...)
+}
+
+// KeyVestingQueue returns the key of the specified vesting end time, symbol and recipient
+func KeyVestingQueue(endTime time.Time, symbol string, recipient sdk.AccAddress) []byte {
+	symbol = strings.ToLower(strings.TrimSpace(symbol))
+	key := append(KeyVestingQueueByTime(endTime), []byte(symbol)...)
+	return append(append(key, Delimiter...), recipient.Bytes()...)
+}
+
+// KeyVestingQueueByTime returns the key prefix of the vesting schedules ending at the specified time
+func KeyVestingQueueByTime(endTime time.Time) []byte {
+	return append(PrefixVestingQueue, sdk.FormatTimeBytes(endTime)...)
+}
+
+// KeyRetiredToken returns the key of the tombstone of the retired token with the specified symbol
+func KeyRetiredToken(symbol string) []byte {
+	symbol = strings.ToLower(strings.TrimSpace(symbol))
+	return append(PrefixRetiredTokens, []byte(symbol)...)
+}
+
+// KeyRetiredMinUnit returns the key of the tombstone of the retired token with the specified min_unit
+func KeyRetiredMinUnit(minUnit string) []byte {
+	minUnit = strings.ToLower(strings.TrimSpace(minUnit))
+	return append(PrefixRetiredMinUnits, []byte(minUnit)...)
+}
+
+// KeySnapshot returns the key of the snapshot of the specified symbol and id
+func KeySnapshot(symbol string, id uint64) []byte {
+	return append(KeySnapshots(symbol), sdk.Uint64ToBigEndian(id)...)
+}
+
+// KeySnapshots returns the key prefix of the snapshots of the specified symbol
+func KeySnapshots(symbol string) []byte {
+	symbol = strings.ToLower(strings.TrimSpace(symbol))
+	return append(append(PrefixSnapshots, []byte(symbol)...), Delimiter...)
+}
+
+// KeySnapshotBalance returns the key of the balance of the holder recorded for the specified symbol and snapshot id
+func KeySnapshotBalance(symbol string, addr sdk.AccAddress, id uint64) []byte {
+	return append(KeySnapshotBalancesByHolder(symbol, addr), sdk.Uint64ToBigEndian(id)...)
+}
+
+// KeySnapshotBalancesByHolder returns the key prefix of the balances of the holder recorded for the specified symbol
+func KeySnapshotBalancesByHolder(symbol string, addr sdk.AccAddress) []byte {
+	return append(KeySnapshotBalances(symbol), addr.Bytes()...)
+}
+
+// KeySnapshotBalances returns the key prefix of the balances recorded for the snapshots of the specified symbol
+func KeySnapshotBalances(symbol string) []byte {
+	symbol = strings.ToLower(strings.TrimSpace(symbol))
+	return append(append(PrefixSnapshotBalances, []byte(symbol)...), Delimiter...)
+}
+
+// KeyDividendIndex returns the key of the dividend index of the specified symbol
+func KeyDividendIndex(symbol string) []byte {
+	symbol = strings.ToLower(strings.TrimSpace(symbol))
+	return append(PrefixDividendIndexes, []byte(symbol)...)
+}
+
+// KeyDividendCheckpoint returns the key of the dividend checkpoint of the specified symbol and holder
+func KeyDividendCheckpoint(symbol string, addr sdk.AccAddress) []byte {
+	return append(KeyDividendCheckpoints(symbol), addr.Bytes()...)
+}
+
+// KeyDividendCheckpoints returns the key prefix of the dividend checkpoints of the specified symbol
+func KeyDividendCheckpoints(symbol string) []byte {
+	symbol = strings.ToLower(strings.TrimSpace(symbol))
+	return append(append(PrefixDividendCheckpoints, []byte(symbol)...), Delimiter...)
+}
+
+// KeyAuction returns the key of the auction of the specified symbol
+func KeyAuction(symbol string) []byte {
+	symbol = strings.ToLower(strings.TrimSpace(symbol))
+	return append(PrefixAuctions, []byte(symbol)...)
+}
+
+// KeyBid returns the key of the bid of the specified bidder in the auction of the symbol
+func KeyBid(symbol string, bidder sdk.AccAddress) []byte {
+	return append(KeyBids(symbol), bidder.Bytes()...)
+}
+
+// KeyBids returns the key prefix of the bids in the auction of the specified symbol
+func KeyBids(symbol string) []byte {
+	symbol = strings.ToLower(strings.TrimSpace(symbol))
+	return append(append(PrefixBids, []byte(symbol)...), Delimiter...)
+}
+
+// KeyAuctionQueue returns the key of the specified reveal end height and symbol
+func KeyAuctionQueue(revealEndHeight int64, symbol string) []byte {
+	symbol = strings.ToLower(strings.TrimSpace(symbol))
+	return append(KeyAuctionQueueByHeight(revealEndHeight), []byte(symbol)...)
+}
+
+// KeyAuctionQueueByHeight returns the key prefix of the auctions whose reveal period ends at the specified height
+func KeyAuctionQueueByHeight(revealEndHeight int64) []byte {
+	return append(PrefixAuctionQueue, sdk.Uint64ToBigEndian(uint64(revealEndHeight))...)
+}
+
+// KeySymbolReservation returns the key of the reservation of the specified symbol
+func KeySymbolReservation(symbol string) []byte {
+	symbol = strings.ToLower(strings.TrimSpace(symbol))
+	return append(PrefixSymbolReservations, []byte(symbol)...)
+}
+
+// KeySymbolReservationQueue returns the key of the specified expiration height and symbol
+func KeySymbolReservationQueue(expireHeight int64, symbol string) []byte {
+	symbol = strings.ToLower(strings.TrimSpace(symbol))
+	return append(KeySymbolReservationQueueByHeight(expireHeight), []byte(symbol)...)
+}
+
+// KeySymbolReservationQueueByHeight returns the key prefix of the symbol reservations expiring at the specified height
+func KeySymbolReservationQueueByHeight(expireHeight int64) []byte {
+	return append(PrefixSymbolReservationQueue, sdk.Uint64ToBigEndian(uint64(expireHeight))...)
+}
+
+// KeyLeaseQueue returns the key of the specified lease expiration height and symbol
+func KeyLeaseQueue(expireHeight int64, symbol string) []byte {
+	symbol = strings.ToLower(strings.TrimSpace(symbol))
+	return append(KeyLeaseQueueByHeight(expireHeight), []byte(symbol)...)
+}
+
+// KeyLeaseQueueByHeight returns the key prefix of the symbol leases expiring at the specified height
+func KeyLeaseQueueByHeight(expireHeight int64) []byte {
+	return append(PrefixLeaseQueue, sdk.Uint64ToBigEndian(uint64(expireHeight))...)
+}
+
+// KeyPendingTransfer returns the key of the pending transfer of the specified symbol
+func KeyPendingTransfer(symbol string) []byte {
+	symbol = strings.ToLower(strings.TrimSpace(symbol))
+	return append(PrefixPendingTransfers, []byte(symbol)...)
+}
+
+// KeyPendingTransferByRecipient returns the key of the specified recipient and symbol. Intended for querying all pending transfers to a recipient
+func KeyPendingTransferByRecipient(recipient sdk.AccAddress, symbol string) []byte {
+	symbol = strings.ToLower(strings.TrimSpace(symbol))
+	return append(append(PrefixPendingTransfersByRecipient, recipient.Bytes()...), []byte(symbol)...)
+}
+
+// KeyPendingTransferQueue returns the key of the specified expiration height and symbol
+func KeyPendingTransferQueue(expireHeight int64, symbol string) []byte {
+	symbol = strings.ToLower(strings.TrimSpace(symbol))
+	return append(KeyPendingTransferQueueByHeight(expireHeight), []byte(symbol)...)
+}
+
+// KeyPendingTransferQueueByHeight returns the key prefix of the pending transfers expiring at the specified height
+func KeyPendingTransferQueueByHeight(expireHeight int64) []byte {
+	return append(PrefixPendingTransferQueue, sdk.Uint64ToBigEndian(uint64(expireHeight))...)
+}
+
+// KeyHolder returns the key of the balance of the holder of the specified min_unit
+func KeyHolder(minUnit string, addr sdk.AccAddress) []byte {
+	return append(KeyHolders(minUnit), addr.Bytes()...)
+}
+
+// KeyHolders returns the key prefix of the balances of the holders of the specified min_unit
+func KeyHolders(minUnit string) []byte {
+	minUnit = strings.ToLower(strings.TrimSpace(minUnit))
+	return append(append(PrefixHolders, []byte(minUnit)...), Delimiter...)
+}
+
+// KeyHolderByBalance returns the key of the holder of the specified min_unit indexed by the balance
+func KeyHolderByBalance(minUnit string, balance sdk.Int, addr sdk.AccAddress) []byte {
+	return append(append(KeyHoldersByBalance(minUnit), descendingBalanceBytes(balance)...), addr.Bytes()...)
+}
+
+// KeyHoldersByBalance returns the key prefix of the holders of the specified min_unit, ordered by balance from the largest
+func KeyHoldersByBalance(minUnit string) []byte {
+	minUnit = strings.ToLower(strings.TrimSpace(minUnit))
+	return append(append(PrefixHoldersByBalance, []byte(minUnit)...), Delimiter...)
+}
+
+// KeyHolderCount returns the key of the number of the holders of the specified min_unit
+func KeyHolderCount(minUnit string) []byte {
+	minUnit = strings.ToLower(strings.TrimSpace(minUnit))
+	return append(PrefixHolderCounts, []byte(minUnit)...)
+}
+
+// KeyTokenHistoryEntry returns the key of the recorded mutation of the specified symbol and sequence
+func KeyTokenHistoryEntry(symbol string, sequence uint64) []byte {
+	return append(KeyTokenHistory(symbol), sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// KeyTokenHistory returns the key prefix of the recorded mutations of the specified symbol
+func KeyTokenHistory(symbol string) []byte {
+	symbol = strings.ToLower(strings.TrimSpace(symbol))
+	return append(append(PrefixTokenHistory, []byte(symbol)...), Delimiter...)
+}
+
+// KeyRetiredTokenHistoryEntry returns the key of the recorded mutation of the specified symbol retired at the height
+func KeyRetiredTokenHistoryEntry(symbol string, retireHeight int64, sequence uint64) []byte {
+	return append(KeyRetiredTokenHistory(symbol, retireHeight), sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// KeyRetiredTokenHistory returns the key prefix of the recorded mutations of the specified symbol retired at the height
+func KeyRetiredTokenHistory(symbol string, retireHeight int64) []byte {
+	symbol = strings.ToLower(strings.TrimSpace(symbol))
+	key := append(append(PrefixRetiredTokenHistory, []byte(symbol)...), Delimiter...)
+	return append(key, sdk.Uint64ToBigEndian(uint64(retireHeight))...)
+}
+
+// descendingBalanceBytes encodes the balance in a fixed length with the bits inverted, so that the larger balances sort first
+func descendingBalanceBytes(balance sdk.Int) []byte {
+	bz := make([]byte, balanceLen)
+	amount := balance.BigInt().Bytes()
+	copy(bz[balanceLen-len(amount):], amount)
+
+	for i := range bz {
+		bz[i] = ^bz[i]
+	}
+	return bz
+}
@@ -3,6 +3,7 @@ package types
 import (
 	"regexp"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -16,18 +17,46 @@ const (
 	TypeMsgEditToken          = "edit_token"
 	TypeMsgMintToken          = "mint_token"
 	TypeMsgTransferTokenOwner = "transfer_token_owner"
+	TypeMsgBurnToken          = "burn_token"
+	TypeMsgFreezeAccount      = "freeze_account"
+	TypeMsgUnfreezeAccount    = "unfreeze_account"
+	TypeMsgPauseToken         = "pause_token"
+	TypeMsgUnpauseToken       = "unpause_token"
+	TypeMsgGrantMinter        = "grant_minter"
+	TypeMsgRevokeMinter       = "revoke_minter"
+
+	TypeMsgAcceptTokenOwner         = "accept_token_owner"
+	TypeMsgCancelTransferTokenOwner = "cancel_transfer_token_owner"
+	TypeMsgGrantRole                = "grant_role"
+	TypeMsgRevokeRole               = "revoke_role"
+	TypeMsgMultiMint                = "multi_mint"
+	TypeMsgRetireToken              = "retire_token"
+	TypeMsgSnapshotToken            = "snapshot_token"
+	TypeMsgDistributeDividend       = "distribute_dividend"
+	TypeMsgClaimDividend            = "claim_dividend"
+	TypeMsgCommitBid                = "commit_bid"
+	TypeMsgRevealBid                = "reveal_bid"
+	TypeMsgRenewSymbol              = "renew_symbol"
+	TypeMsgReleaseVesting           = "release_vesting"
 
 	// constant used to indicate that some field should not be updated
 	DoNotModify = "[do-not-modify]"
 
 	MaximumMaxSupply  = uint64(1000000000000) // maximal limitation for token max supply，1000 billion
 	MaximumInitSupply = uint64(100000000000)  // maximal limitation for token initial supply，100 billion
-	MaximumScale      = uint32(9)             // maximal limitation for token decimal
+	MaximumScale      = uint32(18)            // maximal limitation for token decimal
 	MinimumSymbolLen  = 3                     // minimal limitation for the length of the token's symbol / canonical_symbol
 	MaximumSymbolLen  = 20                    // maximal limitation for the length of the token's symbol / canonical_symbol
 	MaximumNameLen    = 32                    // maximal limitation for the length of the token's name
 	MinimumMinUnitLen = 3                     // minimal limitation for the length of the token's min_unit
 	MaximumMinUnitLen = 20                    // maximal limitation for the length of the token's min_unit
+
+	MaximumDescriptionLen = 280 // maximal limitation for the length of the token's description
+	MaximumWebsiteLen     = 128 // maximal limitation for the length of the token's website
+	MaximumLogoURILen     = 256 // maximal limitation for the length of the token's logo uri
+	MaximumContentHashLen = 128 // maximal limitation for the length of the hash of the token's metadata document
+
+	MaximumMultiMintRecipients = 500 // maximal limitation for the number of recipients of a multi-minting
 )
 
 var (
@@ -35,10 +64,17 @@ var (
 	IsBeginWithAlpha   = regexp.MustCompile(`^[a-zA-Z].*`).MatchString
 )
 
-var _, _, _, _ sdk.Msg = &MsgIssueToken{}, &MsgEditToken{}, &MsgMintToken{}, &MsgTransferTokenOwner{}
+var (
+	_, _, _, _, _ sdk.Msg = &MsgIssueToken{}, &MsgEditToken{}, &MsgMintToken{}, &MsgTransferTokenOwner{}, &MsgBurnToken{}
+	_, _, _, _    sdk.Msg = &MsgFreezeAccount{}, &MsgUnfreezeAccount{}, &MsgPauseToken{}, &MsgUnpauseToken{}
+	_, _, _, _    sdk.Msg = &MsgGrantMinter{}, &MsgRevokeMinter{}, &MsgAcceptTokenOwner{}, &MsgCancelTransferTokenOwner{}
+	_, _, _, _    sdk.Msg = &MsgGrantRole{}, &MsgRevokeRole{}, &MsgMultiMint{}, &MsgRetireToken{}
+	_, _, _, _    sdk.Msg = &MsgSnapshotToken{}, &MsgDistributeDividend{}, &MsgClaimDividend{}, &MsgCommitBid{}
+	_, _, _       sdk.Msg = &MsgRevealBid{}, &MsgRenewSymbol{}, &MsgReleaseVesting{}
+)
 
 // NewMsgIssueToken - construct token issue msg.
-func NewMsgIssueToken(symbol string, minUnit string, name string, scale uint32, initialSupply, maxSupply uint64, mintable bool, owner sdk.AccAddress) *MsgIssueToken {
+func NewMsgIssueToken(symbol string, minUnit string, name string, scale uint32, initialSupply, maxSupply sdk.Int, mintable bool, owner sdk.AccAddress) *MsgIssueToken {
 	return &MsgIssueToken{
 		Symbol:        symbol,
 		Name:          name,
@@ -57,16 +93,29 @@ func (msg MsgIssueToken) Type() string  { return TypeMsgIssueToken }
 
 // Implements Msg.
 func (msg MsgIssueToken) ValidateBasic() error {
-	return ValidateToken(
-		NewToken(msg.Symbol,
-			msg.Name,
-			msg.MinUnit,
-			msg.Scale,
-			msg.InitialSupply,
-			msg.MaxSupply,
-			msg.Mintable,
-			msg.Owner),
-	)
+	if msg.InitialSupply.IsNil() || msg.MaxSupply.IsNil() {
+		return sdkerrors.Wrapf(ErrInvalidInitSupply, "the initial supply and max supply of the token must be specified")
+	}
+
+	token := NewToken(msg.Symbol,
+		msg.Name,
+		msg.MinUnit,
+		msg.Scale,
+		msg.InitialSupply,
+		msg.MaxSupply,
+		msg.Mintable,
+		msg.Owner)
+	token.SetMetadata(msg.Description, msg.Website, msg.LogoURI, msg.ContentHash)
+
+	if err := ValidateToken(token); err != nil {
+		return err
+	}
+
+	if err := ValidateFeeDenom(msg.FeeDenom); err != nil {
+		return err
+	}
+
+	return ValidateAllocations(msg.Allocations, msg.InitialSupply)
 }
 
 // Implements Msg.
@@ -139,16 +188,100 @@ func (msg MsgTransferTokenOwner) Route() string { return MsgRoute }
 // Type implements Msg
 func (msg MsgTransferTokenOwner) Type() string { return TypeMsgTransferTokenOwner }
 
-// NewMsgEditToken creates a MsgEditToken
-func NewMsgEditToken(name, symbol string, maxSupply uint64, mintable Bool, owner sdk.AccAddress) *MsgEditToken {
+// NewMsgAcceptTokenOwner creates a MsgAcceptTokenOwner
+func NewMsgAcceptTokenOwner(symbol string, dstOwner sdk.AccAddress) *MsgAcceptTokenOwner {
+	symbol = strings.TrimSpace(symbol)
+
+	return &MsgAcceptTokenOwner{
+		Symbol:   symbol,
+		DstOwner: dstOwner,
+	}
+}
+
+// Route implements Msg
+func (msg MsgAcceptTokenOwner) Route() string { return MsgRoute }
+
+// Type implements Msg
+func (msg MsgAcceptTokenOwner) Type() string { return TypeMsgAcceptTokenOwner }
+
+// GetSignBytes implements Msg
+func (msg MsgAcceptTokenOwner) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners implements Msg
+func (msg MsgAcceptTokenOwner) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.DstOwner}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgAcceptTokenOwner) ValidateBasic() error {
+	// check the DstOwner
+	if len(msg.DstOwner) == 0 {
+		return sdkerrors.Wrapf(ErrInvalidAddress, "the new owner of the token must be specified")
+	}
+
+	return CheckSymbol(msg.Symbol)
+}
+
+// NewMsgCancelTransferTokenOwner creates a MsgCancelTransferTokenOwner
+func NewMsgCancelTransferTokenOwner(symbol string, owner sdk.AccAddress) *MsgCancelTransferTokenOwner {
+	symbol = strings.TrimSpace(symbol)
+
+	return &MsgCancelTransferTokenOwner{
+		Symbol: symbol,
+		Owner:  owner,
+	}
+}
+
+// Route implements Msg
+func (msg MsgCancelTransferTokenOwner) Route() string { return MsgRoute }
+
+// Type implements Msg
+func (msg MsgCancelTransferTokenOwner) Type() string { return TypeMsgCancelTransferTokenOwner }
+
+// GetSignBytes implements Msg
+func (msg MsgCancelTransferTokenOwner) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners implements Msg
+func (msg MsgCancelTransferTokenOwner) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgCancelTransferTokenOwner) ValidateBasic() error {
+	// check the owner
+	if len(msg.Owner) == 0 {
+		return sdkerrors.Wrapf(ErrInvalidAddress, "the owner of the token must be specified")
+	}
+
+	return CheckSymbol(msg.Symbol)
+}
+
+// NewMsgEditToken creates a MsgEditToken, leaving the metadata of the token unchanged
+func NewMsgEditToken(name, symbol string, maxSupply sdk.Int, mintable Bool, owner sdk.AccAddress) *MsgEditToken {
 	name = strings.TrimSpace(name)
 
 	return &MsgEditToken{
-		Name:      name,
-		Symbol:    symbol,
-		MaxSupply: maxSupply,
-		Mintable:  mintable,
-		Owner:     owner,
+		Name:        name,
+		Symbol:      symbol,
+		MaxSupply:   maxSupply,
+		Mintable:    mintable,
+		Owner:       owner,
+		Description: DoNotModify,
+		Website:     DoNotModify,
+		LogoURI:     DoNotModify,
+		ContentHash: DoNotModify,
 	}
 }
 
@@ -171,8 +304,18 @@ func (msg MsgEditToken) ValidateBasic() error {
 	}
 
 	// check max_supply for fast failed
-	if msg.MaxSupply > MaximumMaxSupply {
-		return sdkerrors.Wrapf(ErrInvalidMaxSupply, "invalid token max supply %d, must be less than %d", msg.MaxSupply, MaximumMaxSupply)
+	if msg.MaxSupply.IsNil() || msg.MaxSupply.IsNegative() || msg.MaxSupply.GT(sdk.NewIntFromUint64(MaximumMaxSupply)) {
+		return sdkerrors.Wrapf(ErrInvalidMaxSupply, "invalid token max supply %s, must be less than %d", msg.MaxSupply, MaximumMaxSupply)
+	}
+
+	// check the metadata, the unmodified fields are checked against empty values
+	if err := ValidateMetadata(
+		doNotModifyOrEmpty(msg.Description),
+		doNotModifyOrEmpty(msg.Website),
+		doNotModifyOrEmpty(msg.LogoURI),
+		doNotModifyOrEmpty(msg.ContentHash),
+	); err != nil {
+		return err
 	}
 
 	// check symbol
@@ -183,6 +326,13 @@ func (msg MsgEditToken) ValidateBasic() error {
 	return nil
 }
 
+func doNotModifyOrEmpty(v string) string {
+	if v == DoNotModify {
+		return ""
+	}
+	return v
+}
+
 // GetSignBytes implements Msg
 func (msg MsgEditToken) GetSignBytes() []byte {
 	b, err := ModuleCdc.MarshalJSON(&msg)
@@ -199,7 +349,7 @@ func (msg MsgEditToken) GetSigners() []sdk.AccAddress {
 }
 
 // NewMsgMintToken creates a MsgMintToken
-func NewMsgMintToken(symbol string, owner, to sdk.AccAddress, amount uint64) *MsgMintToken {
+func NewMsgMintToken(symbol string, owner, to sdk.AccAddress, amount sdk.Int) *MsgMintToken {
 	symbol = strings.TrimSpace(symbol)
 
 	return &MsgMintToken{
@@ -237,8 +387,822 @@ func (msg MsgMintToken) ValidateBasic() error {
 		return sdkerrors.Wrapf(ErrInvalidAddress, "the owner of the token must be specified")
 	}
 
-	if msg.Amount == 0 || msg.Amount > MaximumMaxSupply {
-		return sdkerrors.Wrapf(ErrInvalidMaxSupply, "invalid token amount %d, only accepts value (0, %d]", msg.Amount, MaximumMaxSupply)
+	if msg.Amount.IsNil() || !msg.Amount.IsPositive() || msg.Amount.GT(sdk.NewIntFromUint64(MaximumMaxSupply)) {
+		return sdkerrors.Wrapf(ErrInvalidMaxSupply, "invalid token amount %s, only accepts value (0, %d]", msg.Amount, MaximumMaxSupply)
+	}
+
+	if err := ValidateFeeDenom(msg.FeeDenom); err != nil {
+		return err
+	}
+
+	return CheckSymbol(msg.Symbol)
+}
+
+// NewMsgMultiMint creates a MsgMultiMint
+func NewMsgMultiMint(symbol string, owner sdk.AccAddress, recipients []MintRecipient) *MsgMultiMint {
+	symbol = strings.TrimSpace(symbol)
+
+	return &MsgMultiMint{
+		Symbol:     symbol,
+		Owner:      owner,
+		Recipients: recipients,
+	}
+}
+
+// Route implements Msg
+func (msg MsgMultiMint) Route() string { return MsgRoute }
+
+// Type implements Msg
+func (msg MsgMultiMint) Type() string { return TypeMsgMultiMint }
+
+// GetSignBytes implements Msg
+func (msg MsgMultiMint) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners implements Msg
+func (msg MsgMultiMint) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgMultiMint) ValidateBasic() error {
+	// check the owner
+	if len(msg.Owner) == 0 {
+		return sdkerrors.Wrapf(ErrInvalidAddress, "the owner of the token must be specified")
+	}
+
+	if len(msg.Recipients) == 0 || len(msg.Recipients) > MaximumMultiMintRecipients {
+		return sdkerrors.Wrapf(ErrInvalidAmount, "invalid number of recipients %d, only accepts value (0, %d]", len(msg.Recipients), MaximumMultiMintRecipients)
+	}
+
+	recipients := make(map[string]bool, len(msg.Recipients))
+	for _, recipient := range msg.Recipients {
+		if len(recipient.Address) == 0 {
+			return sdkerrors.Wrapf(ErrInvalidAddress, "the recipient must be specified")
+		}
+
+		if recipients[recipient.Address.String()] {
+			return sdkerrors.Wrapf(ErrInvalidAddress, "duplicate recipient %s", recipient.Address)
+		}
+		recipients[recipient.Address.String()] = true
+
+		if recipient.Amount.IsNil() || !recipient.Amount.IsPositive() || recipient.Amount.GT(sdk.NewIntFromUint64(MaximumMaxSupply)) {
+			return sdkerrors.Wrapf(ErrInvalidMaxSupply, "invalid token amount %s, only accepts value (0, %d]", recipient.Amount, MaximumMaxSupply)
+		}
+	}
+
+	if err := ValidateFeeDenom(msg.FeeDenom); err != nil {
+		return err
+	}
+
+	return CheckSymbol(msg.Symbol)
+}
+
+// TotalAmount returns the total amount minted to all the recipients
+func (msg MsgMultiMint) TotalAmount() sdk.Int {
+	total := sdk.ZeroInt()
+	for _, recipient := range msg.Recipients {
+		total = total.Add(recipient.Amount)
+	}
+	return total
+}
+
+// NewMsgBurnToken creates a MsgBurnToken
+func NewMsgBurnToken(symbol string, sender sdk.AccAddress, amount sdk.Int) *MsgBurnToken {
+	symbol = strings.TrimSpace(symbol)
+
+	return &MsgBurnToken{
+		Symbol: symbol,
+		Amount: amount,
+		Sender: sender,
+	}
+}
+
+// Route implements Msg
+func (msg MsgBurnToken) Route() string { return MsgRoute }
+
+// Type implements Msg
+func (msg MsgBurnToken) Type() string { return TypeMsgBurnToken }
+
+// GetSignBytes implements Msg
+func (msg MsgBurnToken) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners implements Msg
+func (msg MsgBurnToken) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgBurnToken) ValidateBasic() error {
+	// check the sender
+	if len(msg.Sender) == 0 {
+		return sdkerrors.Wrapf(ErrInvalidAddress, "the sender of the token must be specified")
+	}
+
+	if msg.Amount.IsNil() || !msg.Amount.IsPositive() || msg.Amount.GT(sdk.NewIntFromUint64(MaximumMaxSupply)) {
+		return sdkerrors.Wrapf(ErrInvalidAmount, "invalid token amount %s, only accepts value (0, %d]", msg.Amount, MaximumMaxSupply)
+	}
+
+	return CheckSymbol(msg.Symbol)
+}
+
+// NewMsgFreezeAccount creates a MsgFreezeAccount
+func NewMsgFreezeAccount(symbol string, owner, address sdk.AccAddress) *MsgFreezeAccount {
+	symbol = strings.TrimSpace(symbol)
+
+	return &MsgFreezeAccount{
+		Symbol:  symbol,
+		Owner:   owner,
+		Address: address,
+	}
+}
+
+// Route implements Msg
+func (msg MsgFreezeAccount) Route() string { return MsgRoute }
+
+// Type implements Msg
+func (msg MsgFreezeAccount) Type() string { return TypeMsgFreezeAccount }
+
+// GetSignBytes implements Msg
+func (msg MsgFreezeAccount) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners implements Msg
+func (msg MsgFreezeAccount) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgFreezeAccount) ValidateBasic() error {
+	return validateFreezeMsg(msg.Symbol, msg.Owner, msg.Address)
+}
+
+// NewMsgUnfreezeAccount creates a MsgUnfreezeAccount
+func NewMsgUnfreezeAccount(symbol string, owner, address sdk.AccAddress) *MsgUnfreezeAccount {
+	symbol = strings.TrimSpace(symbol)
+
+	return &MsgUnfreezeAccount{
+		Symbol:  symbol,
+		Owner:   owner,
+		Address: address,
+	}
+}
+
+// Route implements Msg
+func (msg MsgUnfreezeAccount) Route() string { return MsgRoute }
+
+// Type implements Msg
+func (msg MsgUnfreezeAccount) Type() string { return TypeMsgUnfreezeAccount }
+
+// GetSignBytes implements Msg
+func (msg MsgUnfreezeAccount) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners implements Msg
+func (msg MsgUnfreezeAccount) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgUnfreezeAccount) ValidateBasic() error {
+	return validateFreezeMsg(msg.Symbol, msg.Owner, msg.Address)
+}
+
+func validateFreezeMsg(symbol string, owner, address sdk.AccAddress) error {
+	// check the owner
+	if len(owner) == 0 {
+		return sdkerrors.Wrapf(ErrInvalidAddress, "the owner of the token must be specified")
+	}
+
+	// check the account to be frozen or unfrozen
+	if len(address) == 0 {
+		return sdkerrors.Wrapf(ErrInvalidAddress, "the account must be specified")
+	}
+
+	return CheckSymbol(symbol)
+}
+
+// NewMsgPauseToken creates a MsgPauseToken
+func NewMsgPauseToken(symbol string, owner sdk.AccAddress) *MsgPauseToken {
+	symbol = strings.TrimSpace(symbol)
+
+	return &MsgPauseToken{
+		Symbol: symbol,
+		Owner:  owner,
+	}
+}
+
+// Route implements Msg
+func (msg MsgPauseToken) Route() string { return MsgRoute }
+
+// Type implements Msg
+func (msg MsgPauseToken) Type() string { return TypeMsgPauseToken }
+
+// GetSignBytes implements Msg
+func (msg MsgPauseToken) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners implements Msg
+func (msg MsgPauseToken) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgPauseToken) ValidateBasic() error {
+	// check the owner
+	if len(msg.Owner) == 0 {
+		return sdkerrors.Wrapf(ErrInvalidAddress, "the owner of the token must be specified")
+	}
+
+	return CheckSymbol(msg.Symbol)
+}
+
+// NewMsgUnpauseToken creates a MsgUnpauseToken
+func NewMsgUnpauseToken(symbol string, owner sdk.AccAddress) *MsgUnpauseToken {
+	symbol = strings.TrimSpace(symbol)
+
+	return &MsgUnpauseToken{
+		Symbol: symbol,
+		Owner:  owner,
+	}
+}
+
+// Route implements Msg
+func (msg MsgUnpauseToken) Route() string { return MsgRoute }
+
+// Type implements Msg
+func (msg MsgUnpauseToken) Type() string { return TypeMsgUnpauseToken }
+
+// GetSignBytes implements Msg
+func (msg MsgUnpauseToken) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners implements Msg
+func (msg MsgUnpauseToken) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgUnpauseToken) ValidateBasic() error {
+	// check the owner
+	if len(msg.Owner) == 0 {
+		return sdkerrors.Wrapf(ErrInvalidAddress, "the owner of the token must be specified")
+	}
+
+	return CheckSymbol(msg.Symbol)
+}
+
+// NewMsgGrantMinter creates a MsgGrantMinter
+func NewMsgGrantMinter(symbol string, owner, minter sdk.AccAddress, quota sdk.Int, expiry *time.Time) *MsgGrantMinter {
+	symbol = strings.TrimSpace(symbol)
+
+	return &MsgGrantMinter{
+		Symbol: symbol,
+		Owner:  owner,
+		Minter: minter,
+		Quota:  quota,
+		Expiry: expiry,
+	}
+}
+
+// Route implements Msg
+func (msg MsgGrantMinter) Route() string { return MsgRoute }
+
+// Type implements Msg
+func (msg MsgGrantMinter) Type() string { return TypeMsgGrantMinter }
+
+// GetSignBytes implements Msg
+func (msg MsgGrantMinter) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners implements Msg
+func (msg MsgGrantMinter) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgGrantMinter) ValidateBasic() error {
+	if err := validateMinterMsg(msg.Symbol, msg.Owner, msg.Minter); err != nil {
+		return err
+	}
+
+	if msg.Quota.IsNil() || !msg.Quota.IsPositive() || msg.Quota.GT(sdk.NewIntFromUint64(MaximumMaxSupply)) {
+		return sdkerrors.Wrapf(ErrInvalidAmount, "invalid minter quota %s, only accepts value (0, %d]", msg.Quota, MaximumMaxSupply)
+	}
+
+	return nil
+}
+
+// NewMsgRevokeMinter creates a MsgRevokeMinter
+func NewMsgRevokeMinter(symbol string, owner, minter sdk.AccAddress) *MsgRevokeMinter {
+	symbol = strings.TrimSpace(symbol)
+
+	return &MsgRevokeMinter{
+		Symbol: symbol,
+		Owner:  owner,
+		Minter: minter,
+	}
+}
+
+// Route implements Msg
+func (msg MsgRevokeMinter) Route() string { return MsgRoute }
+
+// Type implements Msg
+func (msg MsgRevokeMinter) Type() string { return TypeMsgRevokeMinter }
+
+// GetSignBytes implements Msg
+func (msg MsgRevokeMinter) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners implements Msg
+func (msg MsgRevokeMinter) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgRevokeMinter) ValidateBasic() error {
+	return validateMinterMsg(msg.Symbol, msg.Owner, msg.Minter)
+}
+
+func validateMinterMsg(symbol string, owner, minter sdk.AccAddress) error {
+	// check the owner
+	if len(owner) == 0 {
+		return sdkerrors.Wrapf(ErrInvalidAddress, "the owner of the token must be specified")
+	}
+
+	// check the minter
+	if len(minter) == 0 {
+		return sdkerrors.Wrapf(ErrInvalidAddress, "the minter must be specified")
+	}
+
+	if owner.Equals(minter) {
+		return sdkerrors.Wrapf(ErrInvalidMinter, "the minter must not be same as the owner")
+	}
+
+	return CheckSymbol(symbol)
+}
+
+// NewMsgGrantRole creates a MsgGrantRole
+func NewMsgGrantRole(symbol string, owner, addr sdk.AccAddress, role Role) *MsgGrantRole {
+	symbol = strings.TrimSpace(symbol)
+
+	return &MsgGrantRole{
+		Symbol:  symbol,
+		Owner:   owner,
+		Address: addr,
+		Role:    role,
+	}
+}
+
+// Route implements Msg
+func (msg MsgGrantRole) Route() string { return MsgRoute }
+
+// Type implements Msg
+func (msg MsgGrantRole) Type() string { return TypeMsgGrantRole }
+
+// GetSignBytes implements Msg
+func (msg MsgGrantRole) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners implements Msg
+func (msg MsgGrantRole) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgGrantRole) ValidateBasic() error {
+	return validateRoleMsg(msg.Symbol, msg.Owner, msg.Address, msg.Role)
+}
+
+// NewMsgRevokeRole creates a MsgRevokeRole
+func NewMsgRevokeRole(symbol string, owner, addr sdk.AccAddress, role Role) *MsgRevokeRole {
+	symbol = strings.TrimSpace(symbol)
+
+	return &MsgRevokeRole{
+		Symbol:  symbol,
+		Owner:   owner,
+		Address: addr,
+		Role:    role,
+	}
+}
+
+// Route implements Msg
+func (msg MsgRevokeRole) Route() string { return MsgRoute }
+
+// Type implements Msg
+func (msg MsgRevokeRole) Type() string { return TypeMsgRevokeRole }
+
+// GetSignBytes implements Msg
+func (msg MsgRevokeRole) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners implements Msg
+func (msg MsgRevokeRole) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgRevokeRole) ValidateBasic() error {
+	return validateRoleMsg(msg.Symbol, msg.Owner, msg.Address, msg.Role)
+}
+
+func validateRoleMsg(symbol string, owner, addr sdk.AccAddress, role Role) error {
+	// check the owner
+	if len(owner) == 0 {
+		return sdkerrors.Wrapf(ErrInvalidAddress, "the owner of the token must be specified")
+	}
+
+	// check the address
+	if len(addr) == 0 {
+		return sdkerrors.Wrapf(ErrInvalidAddress, "the address to grant or revoke the role must be specified")
+	}
+
+	if err := ValidateRole(role); err != nil {
+		return err
+	}
+
+	return CheckSymbol(symbol)
+}
+
+// NewMsgRetireToken creates a MsgRetireToken
+func NewMsgRetireToken(symbol string, owner sdk.AccAddress) *MsgRetireToken {
+	return &MsgRetireToken{
+		Symbol: strings.TrimSpace(symbol),
+		Owner:  owner,
+	}
+}
+
+// Route implements Msg
+func (msg MsgRetireToken) Route() string { return MsgRoute }
+
+// Type implements Msg
+func (msg MsgRetireToken) Type() string { return TypeMsgRetireToken }
+
+// GetSignBytes implements Msg
+func (msg MsgRetireToken) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners implements Msg
+func (msg MsgRetireToken) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgRetireToken) ValidateBasic() error {
+	// check the owner
+	if len(msg.Owner) == 0 {
+		return sdkerrors.Wrapf(ErrInvalidAddress, "the owner of the token must be specified")
+	}
+
+	return CheckSymbol(msg.Symbol)
+}
+
+// NewMsgSnapshotToken creates a MsgSnapshotToken
+func NewMsgSnapshotToken(symbol string, owner sdk.AccAddress) *MsgSnapshotToken {
+	return &MsgSnapshotToken{
+		Symbol: strings.TrimSpace(symbol),
+		Owner:  owner,
+	}
+}
+
+// Route implements Msg
+func (msg MsgSnapshotToken) Route() string { return MsgRoute }
+
+// Type implements Msg
+func (msg MsgSnapshotToken) Type() string { return TypeMsgSnapshotToken }
+
+// GetSignBytes implements Msg
+func (msg MsgSnapshotToken) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners implements Msg
+func (msg MsgSnapshotToken) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgSnapshotToken) ValidateBasic() error {
+	// check the owner
+	if len(msg.Owner) == 0 {
+		return sdkerrors.Wrapf(ErrInvalidAddress, "the owner of the token must be specified")
+	}
+
+	return CheckSymbol(msg.Symbol)
+}
+
+// NewMsgDistributeDividend creates a MsgDistributeDividend
+func NewMsgDistributeDividend(symbol string, owner sdk.AccAddress, amount sdk.Coins) *MsgDistributeDividend {
+	return &MsgDistributeDividend{
+		Symbol: strings.TrimSpace(symbol),
+		Owner:  owner,
+		Amount: amount,
+	}
+}
+
+// Route implements Msg
+func (msg MsgDistributeDividend) Route() string { return MsgRoute }
+
+// Type implements Msg
+func (msg MsgDistributeDividend) Type() string { return TypeMsgDistributeDividend }
+
+// GetSignBytes implements Msg
+func (msg MsgDistributeDividend) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners implements Msg
+func (msg MsgDistributeDividend) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgDistributeDividend) ValidateBasic() error {
+	// check the owner
+	if len(msg.Owner) == 0 {
+		return sdkerrors.Wrapf(ErrInvalidAddress, "the owner of the token must be specified")
+	}
+
+	if msg.Amount.Empty() || !msg.Amount.IsValid() {
+		return sdkerrors.Wrapf(ErrInvalidDividend, "invalid dividend amount %s", msg.Amount)
+	}
+
+	return CheckSymbol(msg.Symbol)
+}
+
+// NewMsgClaimDividend creates a MsgClaimDividend
+func NewMsgClaimDividend(symbol string, holder sdk.AccAddress) *MsgClaimDividend {
+	return &MsgClaimDividend{
+		Symbol: strings.TrimSpace(symbol),
+		Holder: holder,
+	}
+}
+
+// Route implements Msg
+func (msg MsgClaimDividend) Route() string { return MsgRoute }
+
+// Type implements Msg
+func (msg MsgClaimDividend) Type() string { return TypeMsgClaimDividend }
+
+// GetSignBytes implements Msg
+func (msg MsgClaimDividend) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners implements Msg
+func (msg MsgClaimDividend) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Holder}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgClaimDividend) ValidateBasic() error {
+	// check the holder
+	if len(msg.Holder) == 0 {
+		return sdkerrors.Wrapf(ErrInvalidAddress, "the holder of the token must be specified")
+	}
+
+	return CheckSymbol(msg.Symbol)
+}
+
+// NewMsgCommitBid creates a MsgCommitBid
+func NewMsgCommitBid(symbol string, bidder sdk.AccAddress, bidHash string, deposit sdk.Coin) *MsgCommitBid {
+	return &MsgCommitBid{
+		Symbol:  strings.TrimSpace(symbol),
+		Bidder:  bidder,
+		BidHash: strings.ToLower(strings.TrimSpace(bidHash)),
+		Deposit: deposit,
+	}
+}
+
+// Route implements Msg
+func (msg MsgCommitBid) Route() string { return MsgRoute }
+
+// Type implements Msg
+func (msg MsgCommitBid) Type() string { return TypeMsgCommitBid }
+
+// GetSignBytes implements Msg
+func (msg MsgCommitBid) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners implements Msg
+func (msg MsgCommitBid) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Bidder}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgCommitBid) ValidateBasic() error {
+	// check the bidder
+	if len(msg.Bidder) == 0 {
+		return sdkerrors.Wrapf(ErrInvalidAddress, "the bidder must be specified")
+	}
+
+	if err := ValidateBidHash(msg.BidHash); err != nil {
+		return err
+	}
+
+	if !msg.Deposit.IsValid() || !msg.Deposit.IsPositive() {
+		return sdkerrors.Wrapf(ErrInvalidBid, "invalid bid deposit %s", msg.Deposit)
+	}
+
+	return CheckSymbol(msg.Symbol)
+}
+
+// NewMsgRevealBid creates a MsgRevealBid
+func NewMsgRevealBid(symbol string, bidder sdk.AccAddress, amount sdk.Coin, salt string) *MsgRevealBid {
+	return &MsgRevealBid{
+		Symbol: strings.TrimSpace(symbol),
+		Bidder: bidder,
+		Amount: amount,
+		Salt:   salt,
+	}
+}
+
+// Route implements Msg
+func (msg MsgRevealBid) Route() string { return MsgRoute }
+
+// Type implements Msg
+func (msg MsgRevealBid) Type() string { return TypeMsgRevealBid }
+
+// GetSignBytes implements Msg
+func (msg MsgRevealBid) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners implements Msg
+func (msg MsgRevealBid) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Bidder}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgRevealBid) ValidateBasic() error {
+	// check the bidder
+	if len(msg.Bidder) == 0 {
+		return sdkerrors.Wrapf(ErrInvalidAddress, "the bidder must be specified")
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return sdkerrors.Wrapf(ErrInvalidBid, "invalid bid amount %s", msg.Amount)
+	}
+
+	if len(msg.Salt) == 0 {
+		return sdkerrors.Wrapf(ErrInvalidBid, "the salt of the bid must be specified")
+	}
+
+	return CheckSymbol(msg.Symbol)
+}
+
+// NewMsgRenewSymbol creates a MsgRenewSymbol
+func NewMsgRenewSymbol(symbol string, owner sdk.AccAddress) *MsgRenewSymbol {
+	return &MsgRenewSymbol{
+		Symbol: strings.TrimSpace(symbol),
+		Owner:  owner,
+	}
+}
+
+// Route implements Msg
+func (msg MsgRenewSymbol) Route() string { return MsgRoute }
+
+// Type implements Msg
+func (msg MsgRenewSymbol) Type() string { return TypeMsgRenewSymbol }
+
+// GetSignBytes implements Msg
+func (msg MsgRenewSymbol) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners implements Msg
+func (msg MsgRenewSymbol) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgRenewSymbol) ValidateBasic() error {
+	// check the owner
+	if len(msg.Owner) == 0 {
+		return sdkerrors.Wrapf(ErrInvalidAddress, "the owner of the token must be specified")
+	}
+
+	if err := ValidateFeeDenom(msg.FeeDenom); err != nil {
+		return err
+	}
+
+	return CheckSymbol(msg.Symbol)
+}
+
+// NewMsgReleaseVesting creates a MsgReleaseVesting
+func NewMsgReleaseVesting(symbol string, recipient sdk.AccAddress) *MsgReleaseVesting {
+	return &MsgReleaseVesting{
+		Symbol:    strings.TrimSpace(symbol),
+		Recipient: recipient,
+	}
+}
+
+// Route implements Msg
+func (msg MsgReleaseVesting) Route() string { return MsgRoute }
+
+// Type implements Msg
+func (msg MsgReleaseVesting) Type() string { return TypeMsgReleaseVesting }
+
+// GetSignBytes implements Msg
+func (msg MsgReleaseVesting) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners implements Msg
+func (msg MsgReleaseVesting) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Recipient}
+}
+
+// ValidateBasic implements Msg
+func (msg MsgReleaseVesting) ValidateBasic() error {
+	// check the recipient
+	if len(msg.Recipient) == 0 {
+		return sdkerrors.Wrapf(ErrInvalidAddress, "the recipient of the vesting schedule must be specified")
 	}
 
 	return CheckSymbol(msg.Symbol)
@@ -1,7 +1,9 @@
 package types
 
 import (
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -24,19 +26,27 @@ func TestMsgIssueAsset(t *testing.T) {
 		*MsgIssueToken
 		expectPass bool
 	}{
-		{"basic good", NewMsgIssueToken("btc", "satoshi", "Bitcoin Network", 9, 1, 1, true, addr), true},
-		{"symbol empty", NewMsgIssueToken("", "satoshi", "Bitcoin Network", 9, 1, 1, true, addr), false},
-		{"symbol error", NewMsgIssueToken("b&tc", "satoshi", "Bitcoin Network", 9, 1, 1, true, addr), false},
-		{"symbol first letter is num", NewMsgIssueToken("4btc", "satoshi", "Bitcoin Network", 9, 1, 1, true, addr), false},
-		{"symbol too long", NewMsgIssueToken("btc111111111111111111", "satoshi", "Bitcoin Network", 9, 1, 1, true, addr), false},
-		{"symbol too short", NewMsgIssueToken("ht", "satoshi", "Bitcoin Network", 9, 1, 1, true, addr), false},
-		{"name empty", NewMsgIssueToken("btc", "satoshi", "", 9, 1, 1, true, addr), false},
-		{"name blank", NewMsgIssueToken("btc", "satoshi", " ", 9, 1, 1, true, addr), false},
-		{"name too long", NewMsgIssueToken("btc", "satoshi", "Bitcoin Network aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", 9, 1, 1, true, addr), false},
-		{"initial supply is zero", NewMsgIssueToken("btc", "satoshi", "Bitcoin Network", 9, 0, 1, true, addr), true},
-		{"max supply is zero", NewMsgIssueToken("btc", "satoshi", "Bitcoin Network", 9, 1, 0, true, addr), true},
-		{"init supply bigger than max supply", NewMsgIssueToken("btc", "satoshi", "Bitcoin Network", 9, 2, 1, true, addr), false},
-		{"decimal error", NewMsgIssueToken("btc", "satoshi", "Bitcoin Network", 10, 1, 1, true, addr), false},
+		{"basic good", NewMsgIssueToken("btc", "satoshi", "Bitcoin Network", 9, sdk.NewInt(1), sdk.NewInt(1), true, addr), true},
+		{"symbol empty", NewMsgIssueToken("", "satoshi", "Bitcoin Network", 9, sdk.NewInt(1), sdk.NewInt(1), true, addr), false},
+		{"symbol error", NewMsgIssueToken("b&tc", "satoshi", "Bitcoin Network", 9, sdk.NewInt(1), sdk.NewInt(1), true, addr), false},
+		{"symbol first letter is num", NewMsgIssueToken("4btc", "satoshi", "Bitcoin Network", 9, sdk.NewInt(1), sdk.NewInt(1), true, addr), false},
+		{"symbol too long", NewMsgIssueToken("btc111111111111111111", "satoshi", "Bitcoin Network", 9, sdk.NewInt(1), sdk.NewInt(1), true, addr), false},
+		{"symbol too short", NewMsgIssueToken("ht", "satoshi", "Bitcoin Network", 9, sdk.NewInt(1), sdk.NewInt(1), true, addr), false},
+		{"name empty", NewMsgIssueToken("btc", "satoshi", "", 9, sdk.NewInt(1), sdk.NewInt(1), true, addr), false},
+		{"name blank", NewMsgIssueToken("btc", "satoshi", " ", 9, sdk.NewInt(1), sdk.NewInt(1), true, addr), false},
+		{"name too long", NewMsgIssueToken("btc", "satoshi", "Bitcoin Network aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", 9, sdk.NewInt(1), sdk.NewInt(1), true, addr), false},
+		{"initial supply is zero", NewMsgIssueToken("btc", "satoshi", "Bitcoin Network", 9, sdk.NewInt(0), sdk.NewInt(1), true, addr), true},
+		{"max supply is zero", NewMsgIssueToken("btc", "satoshi", "Bitcoin Network", 9, sdk.NewInt(1), sdk.NewInt(0), true, addr), true},
+		{"18 decimals", NewMsgIssueToken("btc", "satoshi", "Bitcoin Network", 18, sdk.NewInt(1), sdk.NewInt(1), true, addr), true},
+		{"init supply bigger than max supply", NewMsgIssueToken("btc", "satoshi", "Bitcoin Network", 9, sdk.NewInt(2), sdk.NewInt(1), true, addr), false},
+		{"decimal error", NewMsgIssueToken("btc", "satoshi", "Bitcoin Network", 19, sdk.NewInt(1), sdk.NewInt(1), true, addr), false},
+		{"with metadata", withMetadata(NewMsgIssueToken("btc", "satoshi", "Bitcoin Network", 9, sdk.NewInt(1), sdk.NewInt(1), true, addr), "Peer-to-peer cash", "https://bitcoin.org"), true},
+		{"description too long", withMetadata(NewMsgIssueToken("btc", "satoshi", "Bitcoin Network", 9, sdk.NewInt(1), sdk.NewInt(1), true, addr), strings.Repeat("a", MaximumDescriptionLen+1), ""), false},
+		{"website too long", withMetadata(NewMsgIssueToken("btc", "satoshi", "Bitcoin Network", 9, sdk.NewInt(1), sdk.NewInt(1), true, addr), "", strings.Repeat("a", MaximumWebsiteLen+1)), false},
+		{"with allocation", withAllocation(NewMsgIssueToken("btc", "satoshi", "Bitcoin Network", 9, sdk.NewInt(10), sdk.NewInt(10), true, addr), addr1, 10), true},
+		{"allocation exceeds initial supply", withAllocation(NewMsgIssueToken("btc", "satoshi", "Bitcoin Network", 9, sdk.NewInt(10), sdk.NewInt(10), true, addr), addr1, 11), false},
+		{"allocation amount is zero", withAllocation(NewMsgIssueToken("btc", "satoshi", "Bitcoin Network", 9, sdk.NewInt(10), sdk.NewInt(10), true, addr), addr1, 0), false},
+		{"allocation recipient empty", withAllocation(NewMsgIssueToken("btc", "satoshi", "Bitcoin Network", 9, sdk.NewInt(10), sdk.NewInt(10), true, addr), emptyAddr, 1), false},
 	}
 
 	for _, tc := range tests {
@@ -48,6 +58,21 @@ func TestMsgIssueAsset(t *testing.T) {
 	}
 }
 
+func withMetadata(msg *MsgIssueToken, description, website string) *MsgIssueToken {
+	msg.Description = description
+	msg.Website = website
+	return msg
+}
+
+func withAllocation(msg *MsgIssueToken, recipient sdk.AccAddress, amount uint64) *MsgIssueToken {
+	msg.Allocations = append(msg.Allocations, VestingAllocation{
+		Recipient: recipient,
+		Amount:    sdk.NewIntFromUint64(amount),
+		EndTime:   time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+	})
+	return msg
+}
+
 // test ValidateBasic for MsgIssueToken
 func TestMsgEditToken(t *testing.T) {
 	owner := sdk.AccAddress([]byte("owner"))
@@ -58,10 +83,12 @@ func TestMsgEditToken(t *testing.T) {
 		*MsgEditToken
 		expectPass bool
 	}{
-		{"native basic good", NewMsgEditToken("BTC Token", "btc", 10000, mintable, owner), true},
-		{"wrong symbol", NewMsgEditToken("BTC Token", "BT", 10000, mintable, owner), false},
-		{"wrong max_supply", NewMsgEditToken("BTC Token", "btc", 10000000000000, mintable, owner), false},
-		{"loss owner", NewMsgEditToken("BTC Token", "btc", 10000, mintable, nil), false},
+		{"native basic good", NewMsgEditToken("BTC Token", "btc", sdk.NewInt(10000), mintable, owner), true},
+		{"wrong symbol", NewMsgEditToken("BTC Token", "BT", sdk.NewInt(10000), mintable, owner), false},
+		{"wrong max_supply", NewMsgEditToken("BTC Token", "btc", sdk.NewInt(10000000000000), mintable, owner), false},
+		{"loss owner", NewMsgEditToken("BTC Token", "btc", sdk.NewInt(10000), mintable, nil), false},
+		{"logo uri too long", &MsgEditToken{Name: "BTC Token", Symbol: "btc", Owner: owner, LogoURI: strings.Repeat("a", MaximumLogoURILen+1)}, false},
+		{"content hash too long", &MsgEditToken{Name: "BTC Token", Symbol: "btc", Owner: owner, ContentHash: strings.Repeat("a", MaximumContentHashLen+1)}, false},
 	}
 
 	for _, tc := range tests {
@@ -80,7 +107,7 @@ func TestMsgEditTokenRoute(t *testing.T) {
 	// build a MsgEditToken
 	msg := MsgEditToken{
 		Symbol:    symbol,
-		MaxSupply: 10000000,
+		MaxSupply: sdk.NewInt(10000000),
 		Mintable:  mintable,
 	}
 
@@ -94,7 +121,7 @@ func TestMsgEditTokenGetSignBytes(t *testing.T) {
 		Name:      "BTC TOKEN",
 		Owner:     sdk.AccAddress([]byte("owner")),
 		Symbol:    "btc",
-		MaxSupply: 21000000,
+		MaxSupply: sdk.NewInt(21000000),
 		Mintable:  mintable,
 	}
 
@@ -110,17 +137,17 @@ func TestMsgMintTokenValidateBasic(t *testing.T) {
 		symbol     string
 		owner      sdk.AccAddress
 		to         sdk.AccAddress
-		amount     uint64
+		amount     sdk.Int
 		expectPass bool
 	}{
-		{"empty symbol", "", addr1, addr2, 1000, false},
-		{"wrong symbol", "bt", addr1, addr2, 1000, false},
-		{"empty owner", "btc", emptyAddr, addr2, 1000, false},
-		{"empty to", "btc", addr1, emptyAddr, 1000, true},
-		{"not empty to", "btc", addr1, addr2, 1000, true},
-		{"invalid amount", "btc", addr1, addr2, 0, false},
-		{"exceed max supply", "btc", addr1, addr2, 100000000000000, false},
-		{"basic good", "btc", addr1, addr2, 1000, true},
+		{"empty symbol", "", addr1, addr2, sdk.NewInt(1000), false},
+		{"wrong symbol", "bt", addr1, addr2, sdk.NewInt(1000), false},
+		{"empty owner", "btc", emptyAddr, addr2, sdk.NewInt(1000), false},
+		{"empty to", "btc", addr1, emptyAddr, sdk.NewInt(1000), true},
+		{"not empty to", "btc", addr1, addr2, sdk.NewInt(1000), true},
+		{"invalid amount", "btc", addr1, addr2, sdk.NewInt(0), false},
+		{"exceed max supply", "btc", addr1, addr2, sdk.NewInt(100000000000000), false},
+		{"basic good", "btc", addr1, addr2, sdk.NewInt(1000), true},
 	}
 
 	for _, td := range testData {
@@ -131,6 +158,41 @@ func TestMsgMintTokenValidateBasic(t *testing.T) {
 			require.NotNil(t, msg.ValidateBasic(), "test: %v", td.msg)
 		}
 	}
+
+	// the fee denom is optional but must be valid if specified
+	msg := NewMsgMintToken("btc", addr1, addr2, sdk.NewInt(1000))
+	msg.FeeDenom = "usdc"
+	require.Nil(t, msg.ValidateBasic())
+	msg.FeeDenom = "1usdc"
+	require.NotNil(t, msg.ValidateBasic())
+}
+
+func TestMsgMultiMintValidateBasic(t *testing.T) {
+	testData := []struct {
+		msg        string
+		symbol     string
+		owner      sdk.AccAddress
+		recipients []MintRecipient
+		expectPass bool
+	}{
+		{"wrong symbol", "bt", addr1, []MintRecipient{{addr2, sdk.NewInt(1000)}}, false},
+		{"empty owner", "btc", emptyAddr, []MintRecipient{{addr2, sdk.NewInt(1000)}}, false},
+		{"no recipients", "btc", addr1, nil, false},
+		{"empty recipient", "btc", addr1, []MintRecipient{{emptyAddr, sdk.NewInt(1000)}}, false},
+		{"duplicate recipients", "btc", addr1, []MintRecipient{{addr2, sdk.NewInt(1000)}, {addr2, sdk.NewInt(1000)}}, false},
+		{"invalid amount", "btc", addr1, []MintRecipient{{addr1, sdk.NewInt(1000)}, {addr2, sdk.NewInt(0)}}, false},
+		{"exceed max supply", "btc", addr1, []MintRecipient{{addr2, sdk.NewInt(100000000000000)}}, false},
+		{"basic good", "btc", addr1, []MintRecipient{{addr1, sdk.NewInt(1000)}, {addr2, sdk.NewInt(1000)}}, true},
+	}
+
+	for _, td := range testData {
+		msg := NewMsgMultiMint(td.symbol, td.owner, td.recipients)
+		if td.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", td.msg)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", td.msg)
+		}
+	}
 }
 
 func TestMsgTransferTokenOwnerValidation(t *testing.T) {
@@ -157,3 +219,232 @@ func TestMsgTransferTokenOwnerValidation(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgBurnTokenValidateBasic(t *testing.T) {
+	testData := []struct {
+		msg        string
+		symbol     string
+		sender     sdk.AccAddress
+		amount     sdk.Int
+		expectPass bool
+	}{
+		{"empty symbol", "", addr1, sdk.NewInt(1000), false},
+		{"wrong symbol", "bt", addr1, sdk.NewInt(1000), false},
+		{"empty sender", "btc", emptyAddr, sdk.NewInt(1000), false},
+		{"invalid amount", "btc", addr1, sdk.NewInt(0), false},
+		{"empty amount", "btc", addr1, sdk.Int{}, false},
+		{"exceed max supply", "btc", addr1, sdk.NewInt(100000000000000), false},
+		{"basic good", "btc", addr1, sdk.NewInt(1000), true},
+	}
+
+	for _, td := range testData {
+		msg := NewMsgBurnToken(td.symbol, td.sender, td.amount)
+		if td.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", td.msg)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", td.msg)
+		}
+	}
+}
+
+func TestMsgRetireTokenValidateBasic(t *testing.T) {
+	testData := []struct {
+		msg        string
+		symbol     string
+		owner      sdk.AccAddress
+		expectPass bool
+	}{
+		{"empty symbol", "", addr1, false},
+		{"wrong symbol", "bt", addr1, false},
+		{"empty owner", "btc", emptyAddr, false},
+		{"basic good", "btc", addr1, true},
+	}
+
+	for _, td := range testData {
+		msg := NewMsgRetireToken(td.symbol, td.owner)
+		if td.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", td.msg)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", td.msg)
+		}
+	}
+}
+
+func TestMsgSnapshotTokenValidateBasic(t *testing.T) {
+	testData := []struct {
+		msg        string
+		symbol     string
+		owner      sdk.AccAddress
+		expectPass bool
+	}{
+		{"empty symbol", "", addr1, false},
+		{"wrong symbol", "bt", addr1, false},
+		{"empty owner", "btc", emptyAddr, false},
+		{"basic good", "btc", addr1, true},
+	}
+
+	for _, td := range testData {
+		msg := NewMsgSnapshotToken(td.symbol, td.owner)
+		if td.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", td.msg)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", td.msg)
+		}
+	}
+}
+
+func TestMsgDistributeDividendValidateBasic(t *testing.T) {
+	testData := []struct {
+		msg        string
+		symbol     string
+		owner      sdk.AccAddress
+		amount     sdk.Coins
+		expectPass bool
+	}{
+		{"empty symbol", "", addr1, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), false},
+		{"empty owner", "btc", emptyAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), false},
+		{"empty amount", "btc", addr1, sdk.Coins{}, false},
+		{"zero amount", "btc", addr1, sdk.Coins{sdk.NewInt64Coin("stake", 0)}, false},
+		{"basic good", "btc", addr1, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), true},
+	}
+
+	for _, td := range testData {
+		msg := NewMsgDistributeDividend(td.symbol, td.owner, td.amount)
+		if td.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", td.msg)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", td.msg)
+		}
+	}
+}
+
+func TestMsgClaimDividendValidateBasic(t *testing.T) {
+	testData := []struct {
+		msg        string
+		symbol     string
+		holder     sdk.AccAddress
+		expectPass bool
+	}{
+		{"empty symbol", "", addr1, false},
+		{"empty holder", "btc", emptyAddr, false},
+		{"basic good", "btc", addr1, true},
+	}
+
+	for _, td := range testData {
+		msg := NewMsgClaimDividend(td.symbol, td.holder)
+		if td.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", td.msg)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", td.msg)
+		}
+	}
+}
+
+func TestMsgCommitBidValidateBasic(t *testing.T) {
+	bidHash := BidHash("btc", addr1, sdk.NewInt64Coin("stake", 100), "salt")
+
+	testData := []struct {
+		msg        string
+		symbol     string
+		bidder     sdk.AccAddress
+		bidHash    string
+		deposit    sdk.Coin
+		expectPass bool
+	}{
+		{"empty symbol", "", addr1, bidHash, sdk.NewInt64Coin("stake", 100), false},
+		{"empty bidder", "btc", emptyAddr, bidHash, sdk.NewInt64Coin("stake", 100), false},
+		{"short bid hash", "btc", addr1, bidHash[1:], sdk.NewInt64Coin("stake", 100), false},
+		{"non-hex bid hash", "btc", addr1, strings.Repeat("z", BidHashLen), sdk.NewInt64Coin("stake", 100), false},
+		{"uppercase bid hash", "btc", addr1, strings.ToUpper(bidHash), sdk.NewInt64Coin("stake", 100), true},
+		{"zero deposit", "btc", addr1, bidHash, sdk.NewInt64Coin("stake", 0), false},
+		{"basic good", "btc", addr1, bidHash, sdk.NewInt64Coin("stake", 100), true},
+	}
+
+	for _, td := range testData {
+		msg := NewMsgCommitBid(td.symbol, td.bidder, td.bidHash, td.deposit)
+		if td.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", td.msg)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", td.msg)
+		}
+	}
+
+	// the uppercase hash is normalised by the constructor only
+	msg := MsgCommitBid{Symbol: "btc", Bidder: addr1, BidHash: strings.ToUpper(bidHash), Deposit: sdk.NewInt64Coin("stake", 100)}
+	require.NotNil(t, msg.ValidateBasic())
+}
+
+func TestMsgRevealBidValidateBasic(t *testing.T) {
+	testData := []struct {
+		msg        string
+		symbol     string
+		bidder     sdk.AccAddress
+		amount     sdk.Coin
+		salt       string
+		expectPass bool
+	}{
+		{"empty symbol", "", addr1, sdk.NewInt64Coin("stake", 100), "salt", false},
+		{"empty bidder", "btc", emptyAddr, sdk.NewInt64Coin("stake", 100), "salt", false},
+		{"zero amount", "btc", addr1, sdk.NewInt64Coin("stake", 0), "salt", false},
+		{"empty salt", "btc", addr1, sdk.NewInt64Coin("stake", 100), "", false},
+		{"basic good", "btc", addr1, sdk.NewInt64Coin("stake", 100), "salt", true},
+	}
+
+	for _, td := range testData {
+		msg := NewMsgRevealBid(td.symbol, td.bidder, td.amount, td.salt)
+		if td.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", td.msg)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", td.msg)
+		}
+	}
+}
+
+func TestMsgRenewSymbolValidateBasic(t *testing.T) {
+	testData := []struct {
+		msg        string
+		symbol     string
+		owner      sdk.AccAddress
+		feeDenom   string
+		expectPass bool
+	}{
+		{"empty symbol", "", addr1, "", false},
+		{"wrong symbol", "bt", addr1, "", false},
+		{"empty owner", "btc", emptyAddr, "", false},
+		{"wrong fee denom", "btc", addr1, "1usdc", false},
+		{"basic good", "btc", addr1, "", true},
+		{"basic good with fee denom", "btc", addr1, "usdc", true},
+	}
+
+	for _, td := range testData {
+		msg := NewMsgRenewSymbol(td.symbol, td.owner)
+		msg.FeeDenom = td.feeDenom
+		if td.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", td.msg)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", td.msg)
+		}
+	}
+}
+
+func TestMsgReleaseVestingValidateBasic(t *testing.T) {
+	testData := []struct {
+		msg        string
+		symbol     string
+		recipient  sdk.AccAddress
+		expectPass bool
+	}{
+		{"empty symbol", "", addr1, false},
+		{"empty recipient", "btc", emptyAddr, false},
+		{"basic good", "btc", addr1, true},
+	}
+
+	for _, td := range testData {
+		msg := NewMsgReleaseVesting(td.symbol, td.recipient)
+		if td.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", td.msg)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", td.msg)
+		}
+	}
+}
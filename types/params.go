@@ -6,6 +6,7 @@ import (
 	"gopkg.in/yaml.v2"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
@@ -13,9 +14,18 @@ var _ paramtypes.ParamSet = (*Params)(nil)
 
 // parameter keys
 var (
-	KeyTokenTaxRate      = []byte("TokenTaxRate")
-	KeyIssueTokenBaseFee = []byte("IssueTokenBaseFee")
-	KeyMintTokenFeeRatio = []byte("MintTokenFeeRatio")
+	KeyTokenTaxRate            = []byte("TokenTaxRate")
+	KeyIssueTokenBaseFee       = []byte("IssueTokenBaseFee")
+	KeyMintTokenFeeRatio       = []byte("MintTokenFeeRatio")
+	KeyPendingTransferPeriod   = []byte("PendingTransferPeriod")
+	KeyMultiMintFeeRatio       = []byte("MultiMintFeeRatio")
+	KeyRetiredSymbolCooldown   = []byte("RetiredSymbolCooldown")
+	KeyFeeDenoms               = []byte("FeeDenoms")
+	KeyAuctionSymbolLength     = []byte("AuctionSymbolLength")
+	KeyBidCommitPeriod         = []byte("BidCommitPeriod")
+	KeyBidRevealPeriod         = []byte("BidRevealPeriod")
+	KeySymbolReservationPeriod = []byte("SymbolReservationPeriod")
+	KeySymbolLeasePeriod       = []byte("SymbolLeasePeriod")
 )
 
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
@@ -23,17 +33,37 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyTokenTaxRate, &p.TokenTaxRate, validateTaxRate),
 		paramtypes.NewParamSetPair(KeyIssueTokenBaseFee, &p.IssueTokenBaseFee, validateIssueTokenBaseFee),
 		paramtypes.NewParamSetPair(KeyMintTokenFeeRatio, &p.MintTokenFeeRatio, validateMintTokenFeeRatio),
+		paramtypes.NewParamSetPair(KeyPendingTransferPeriod, &p.PendingTransferPeriod, validatePendingTransferPeriod),
+		paramtypes.NewParamSetPair(KeyMultiMintFeeRatio, &p.MultiMintFeeRatio, validateMultiMintFeeRatio),
+		paramtypes.NewParamSetPair(KeyRetiredSymbolCooldown, &p.RetiredSymbolCooldown, validateRetiredSymbolCooldown),
+		paramtypes.NewParamSetPair(KeyFeeDenoms, &p.FeeDenoms, validateFeeDenoms),
+		paramtypes.NewParamSetPair(KeyAuctionSymbolLength, &p.AuctionSymbolLength, validateAuctionSymbolLength),
+		paramtypes.NewParamSetPair(KeyBidCommitPeriod, &p.BidCommitPeriod, validateBidPeriod),
+		paramtypes.NewParamSetPair(KeyBidRevealPeriod, &p.BidRevealPeriod, validateBidPeriod),
+		paramtypes.NewParamSetPair(KeySymbolReservationPeriod, &p.SymbolReservationPeriod, validateSymbolReservationPeriod),
+		paramtypes.NewParamSetPair(KeySymbolLeasePeriod, &p.SymbolLeasePeriod, validateSymbolLeasePeriod),
 	}
 }
 
 // NewParams token params constructor
 func NewParams(tokenTaxRate sdk.Dec, issueTokenBaseFee sdk.Coin,
-	mintTokenFeeRatio sdk.Dec,
+	mintTokenFeeRatio sdk.Dec, pendingTransferPeriod uint64, multiMintFeeRatio sdk.Dec,
+	retiredSymbolCooldown uint64, feeDenoms []FeeDenom, auctionSymbolLength uint32,
+	bidCommitPeriod, bidRevealPeriod, symbolReservationPeriod, symbolLeasePeriod uint64,
 ) Params {
 	return Params{
-		TokenTaxRate:      tokenTaxRate,
-		IssueTokenBaseFee: issueTokenBaseFee,
-		MintTokenFeeRatio: mintTokenFeeRatio,
+		TokenTaxRate:            tokenTaxRate,
+		IssueTokenBaseFee:       issueTokenBaseFee,
+		MintTokenFeeRatio:       mintTokenFeeRatio,
+		PendingTransferPeriod:   pendingTransferPeriod,
+		MultiMintFeeRatio:       multiMintFeeRatio,
+		RetiredSymbolCooldown:   retiredSymbolCooldown,
+		FeeDenoms:               feeDenoms,
+		AuctionSymbolLength:     auctionSymbolLength,
+		BidCommitPeriod:         bidCommitPeriod,
+		BidRevealPeriod:         bidRevealPeriod,
+		SymbolReservationPeriod: symbolReservationPeriod,
+		SymbolLeasePeriod:       symbolLeasePeriod,
 	}
 }
 
@@ -46,9 +76,18 @@ func ParamKeyTable() paramtypes.KeyTable {
 func DefaultParams() Params {
 	defaultToken := GetNativeToken()
 	return Params{
-		TokenTaxRate:      sdk.NewDecWithPrec(4, 1), // 0.4 (40%)
-		IssueTokenBaseFee: sdk.NewCoin(defaultToken.Symbol, sdk.NewIntWithDecimal(60000, int(defaultToken.Scale))),
-		MintTokenFeeRatio: sdk.NewDecWithPrec(1, 1), // 0.1 (10%)
+		TokenTaxRate:            sdk.NewDecWithPrec(4, 1), // 0.4 (40%)
+		IssueTokenBaseFee:       sdk.NewCoin(defaultToken.Symbol, sdk.NewIntWithDecimal(60000, int(defaultToken.Scale))),
+		MintTokenFeeRatio:       sdk.NewDecWithPrec(1, 1), // 0.1 (10%)
+		PendingTransferPeriod:   120960,                   // about 7 days with 5s blocks
+		MultiMintFeeRatio:       sdk.ZeroDec(),            // the mint fee is charged once for all the recipients
+		RetiredSymbolCooldown:   518400,                   // about 30 days with 5s blocks
+		FeeDenoms:               nil,                      // the fees are only charged in the denom of the issuing base fee
+		AuctionSymbolLength:     0,                        // no symbol is auctioned
+		BidCommitPeriod:         17280,                    // about 1 day with 5s blocks
+		BidRevealPeriod:         17280,                    // about 1 day with 5s blocks
+		SymbolReservationPeriod: 120960,                   // about 7 days with 5s blocks
+		SymbolLeasePeriod:       0,                        // the symbols are registered forever
 	}
 }
 
@@ -68,6 +107,33 @@ func ValidateParams(p Params) error {
 	if err := validateIssueTokenBaseFee(p.IssueTokenBaseFee); err != nil {
 		return err
 	}
+	if err := validatePendingTransferPeriod(p.PendingTransferPeriod); err != nil {
+		return err
+	}
+	if err := validateMultiMintFeeRatio(p.MultiMintFeeRatio); err != nil {
+		return err
+	}
+	if err := validateRetiredSymbolCooldown(p.RetiredSymbolCooldown); err != nil {
+		return err
+	}
+	if err := validateFeeDenoms(p.FeeDenoms); err != nil {
+		return err
+	}
+	if err := validateAuctionSymbolLength(p.AuctionSymbolLength); err != nil {
+		return err
+	}
+	if err := validateBidPeriod(p.BidCommitPeriod); err != nil {
+		return err
+	}
+	if err := validateBidPeriod(p.BidRevealPeriod); err != nil {
+		return err
+	}
+	if err := validateSymbolReservationPeriod(p.SymbolReservationPeriod); err != nil {
+		return err
+	}
+	if err := validateSymbolLeasePeriod(p.SymbolLeasePeriod); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -105,3 +171,102 @@ func validateIssueTokenBaseFee(i interface{}) error {
 	}
 	return nil
 }
+
+func validatePendingTransferPeriod(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateMultiMintFeeRatio(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.GT(sdk.NewDec(1)) || v.LT(sdk.ZeroDec()) {
+		return fmt.Errorf("fee ratio for each additional recipient of multi-minting [%s] should be between [0, 1]", v.String())
+	}
+	return nil
+}
+
+func validateRetiredSymbolCooldown(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateFeeDenoms(i interface{}) error {
+	v, ok := i.([]FeeDenom)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	denoms := make(map[string]bool, len(v))
+	for _, feeDenom := range v {
+		if err := sdk.ValidateDenom(feeDenom.Denom); err != nil {
+			return fmt.Errorf("invalid fee denom [%s]: %s", feeDenom.Denom, err)
+		}
+		if denoms[feeDenom.Denom] {
+			return fmt.Errorf("duplicate fee denom [%s]", feeDenom.Denom)
+		}
+		denoms[feeDenom.Denom] = true
+
+		if feeDenom.Rate.IsNil() || !feeDenom.Rate.IsPositive() {
+			return fmt.Errorf("conversion rate of the fee denom [%s] should be positive", feeDenom.Denom)
+		}
+	}
+	return nil
+}
+
+func validateAuctionSymbolLength(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v > MaximumSymbolLen+1 {
+		return fmt.Errorf("symbol length threshold of the auctions [%d] should not be greater than %d", v, MaximumSymbolLen+1)
+	}
+	return nil
+}
+
+func validateBidPeriod(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("bid period should be positive")
+	}
+	return nil
+}
+
+func validateSymbolReservationPeriod(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("symbol reservation period should be positive")
+	}
+	return nil
+}
+
+func validateSymbolLeasePeriod(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+// ValidateFeeDenom checks the optional fee denom of a message
+func ValidateFeeDenom(denom string) error {
+	if len(denom) == 0 {
+		return nil
+	}
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidFeeDenom, "invalid fee denom %s: %s", denom, err)
+	}
+	return nil
+}
@@ -18,17 +18,26 @@ func TestValidateParams(t *testing.T) {
 	}{
 		{"Minimum value",
 			Params{
-				TokenTaxRate:      sdk.ZeroDec(),
-				MintTokenFeeRatio: sdk.ZeroDec(),
-				IssueTokenBaseFee: sdk.NewCoin(defaultToken.Symbol, sdk.ZeroInt()),
+				TokenTaxRate:            sdk.ZeroDec(),
+				MintTokenFeeRatio:       sdk.ZeroDec(),
+				MultiMintFeeRatio:       sdk.ZeroDec(),
+				IssueTokenBaseFee:       sdk.NewCoin(defaultToken.Symbol, sdk.ZeroInt()),
+				BidCommitPeriod:         1,
+				BidRevealPeriod:         1,
+				SymbolReservationPeriod: 1,
 			},
 			true,
 		},
 		{"Maximum value",
 			Params{
-				TokenTaxRate:      sdk.NewDec(1),
-				MintTokenFeeRatio: sdk.NewDec(1),
-				IssueTokenBaseFee: sdk.NewCoin(defaultToken.Symbol, sdk.NewInt(math.MaxInt64)),
+				TokenTaxRate:            sdk.NewDec(1),
+				MintTokenFeeRatio:       sdk.NewDec(1),
+				MultiMintFeeRatio:       sdk.ZeroDec(),
+				IssueTokenBaseFee:       sdk.NewCoin(defaultToken.Symbol, sdk.NewInt(math.MaxInt64)),
+				AuctionSymbolLength:     MaximumSymbolLen + 1,
+				BidCommitPeriod:         math.MaxUint64,
+				BidRevealPeriod:         math.MaxUint64,
+				SymbolReservationPeriod: math.MaxUint64,
 			},
 			true,
 		},
@@ -36,6 +45,7 @@ func TestValidateParams(t *testing.T) {
 			Params{
 				TokenTaxRate:      sdk.NewDecWithPrec(-1, 1),
 				MintTokenFeeRatio: sdk.NewDec(0),
+				MultiMintFeeRatio: sdk.ZeroDec(),
 				IssueTokenBaseFee: sdk.NewCoin(defaultToken.Symbol, sdk.NewInt(1)),
 			},
 			false,
@@ -44,6 +54,7 @@ func TestValidateParams(t *testing.T) {
 			Params{
 				TokenTaxRate:      sdk.NewDec(0),
 				MintTokenFeeRatio: sdk.NewDecWithPrec(-1, 1),
+				MultiMintFeeRatio: sdk.ZeroDec(),
 				IssueTokenBaseFee: sdk.NewCoin(defaultToken.Symbol, sdk.NewInt(1)),
 			},
 			false,
@@ -52,6 +63,7 @@ func TestValidateParams(t *testing.T) {
 			Params{
 				TokenTaxRate:      sdk.NewDecWithPrec(11, 1),
 				MintTokenFeeRatio: sdk.NewDec(1),
+				MultiMintFeeRatio: sdk.ZeroDec(),
 				IssueTokenBaseFee: sdk.NewCoin(defaultToken.Symbol, sdk.NewInt(1)),
 			},
 			false,
@@ -60,6 +72,16 @@ func TestValidateParams(t *testing.T) {
 			Params{
 				TokenTaxRate:      sdk.NewDec(1),
 				MintTokenFeeRatio: sdk.NewDecWithPrec(11, 1),
+				MultiMintFeeRatio: sdk.ZeroDec(),
+				IssueTokenBaseFee: sdk.NewCoin(defaultToken.Symbol, sdk.NewInt(1)),
+			},
+			false,
+		},
+		{"MultiMintFeeRatio greater than the maximum",
+			Params{
+				TokenTaxRate:      sdk.NewDec(1),
+				MintTokenFeeRatio: sdk.NewDec(1),
+				MultiMintFeeRatio: sdk.NewDecWithPrec(11, 1),
 				IssueTokenBaseFee: sdk.NewCoin(defaultToken.Symbol, sdk.NewInt(1)),
 			},
 			false,
@@ -68,10 +90,70 @@ func TestValidateParams(t *testing.T) {
 			Params{
 				TokenTaxRate:      sdk.NewDec(1),
 				MintTokenFeeRatio: sdk.NewDec(1),
+				MultiMintFeeRatio: sdk.ZeroDec(),
 				IssueTokenBaseFee: sdk.Coin{Denom: defaultToken.Symbol, Amount: sdk.NewInt(-1)},
 			},
 			false,
 		},
+		{"Valid fee denoms",
+			Params{
+				TokenTaxRate:            sdk.NewDec(1),
+				MintTokenFeeRatio:       sdk.NewDec(1),
+				MultiMintFeeRatio:       sdk.ZeroDec(),
+				IssueTokenBaseFee:       sdk.NewCoin(defaultToken.Symbol, sdk.NewInt(1)),
+				FeeDenoms:               []FeeDenom{{Denom: "usdc", Rate: sdk.NewDecWithPrec(5, 1)}, {Denom: "atom", Rate: sdk.NewDec(2)}},
+				BidCommitPeriod:         1,
+				BidRevealPeriod:         1,
+				SymbolReservationPeriod: 1,
+			},
+			true,
+		},
+		{"Fee denom is duplicate",
+			Params{
+				TokenTaxRate:      sdk.NewDec(1),
+				MintTokenFeeRatio: sdk.NewDec(1),
+				MultiMintFeeRatio: sdk.ZeroDec(),
+				IssueTokenBaseFee: sdk.NewCoin(defaultToken.Symbol, sdk.NewInt(1)),
+				FeeDenoms:         []FeeDenom{{Denom: "usdc", Rate: sdk.NewDec(1)}, {Denom: "usdc", Rate: sdk.NewDec(2)}},
+			},
+			false,
+		},
+		{"Fee denom rate is not positive",
+			Params{
+				TokenTaxRate:      sdk.NewDec(1),
+				MintTokenFeeRatio: sdk.NewDec(1),
+				MultiMintFeeRatio: sdk.ZeroDec(),
+				IssueTokenBaseFee: sdk.NewCoin(defaultToken.Symbol, sdk.NewInt(1)),
+				FeeDenoms:         []FeeDenom{{Denom: "usdc", Rate: sdk.ZeroDec()}},
+			},
+			false,
+		},
+		{"AuctionSymbolLength greater than the maximum",
+			Params{
+				TokenTaxRate:            sdk.NewDec(1),
+				MintTokenFeeRatio:       sdk.NewDec(1),
+				MultiMintFeeRatio:       sdk.ZeroDec(),
+				IssueTokenBaseFee:       sdk.NewCoin(defaultToken.Symbol, sdk.NewInt(1)),
+				AuctionSymbolLength:     MaximumSymbolLen + 2,
+				BidCommitPeriod:         1,
+				BidRevealPeriod:         1,
+				SymbolReservationPeriod: 1,
+			},
+			false,
+		},
+		{"BidRevealPeriod is zero",
+			Params{
+				TokenTaxRate:            sdk.NewDec(1),
+				MintTokenFeeRatio:       sdk.NewDec(1),
+				MultiMintFeeRatio:       sdk.ZeroDec(),
+				IssueTokenBaseFee:       sdk.NewCoin(defaultToken.Symbol, sdk.NewInt(1)),
+				AuctionSymbolLength:     4,
+				BidCommitPeriod:         1,
+				BidRevealPeriod:         0,
+				SymbolReservationPeriod: 1,
+			},
+			false,
+		},
 	}
 
 	for _, tc := range tests {
@@ -2,13 +2,17 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 const (
-	QueryToken  = "token"
-	QueryTokens = "tokens"
-	QueryFees   = "fees"
-	QueryParams = "params"
+	QueryToken       = "token"
+	QueryTokens      = "tokens"
+	QuerySupply      = "supply"
+	QueryHolders     = "holders"
+	QueryHolderCount = "holder_count"
+	QueryFees        = "fees"
+	QueryParams      = "params"
 )
 
 // QueryTokenParams is the query parameters for 'custom/token/token'
@@ -18,10 +22,36 @@ type QueryTokenParams struct {
 
 // QueryTokensParams is the query parameters for 'custom/token/tokens'
 type QueryTokensParams struct {
-	Owner sdk.AccAddress
+	Owner        sdk.AccAddress
+	Mintable     Bool
+	SymbolPrefix string
+	Pagination   *query.PageRequest
+}
+
+// QueryTokensResult is the result of 'custom/token/tokens'
+type QueryTokensResult struct {
+	Tokens     []TokenI            `json:"tokens" yaml:"tokens"`
+	Pagination *query.PageResponse `json:"pagination" yaml:"pagination"`
+}
+
+// QuerySupplyParams is the query parameters for 'custom/token/supply'
+type QuerySupplyParams struct {
+	Denom string
+}
+
+// QueryHoldersParams is the query parameters for 'custom/token/holders'
+type QueryHoldersParams struct {
+	Denom      string
+	Pagination *query.PageRequest
+}
+
+// QueryHolderCountParams is the query parameters for 'custom/token/holder_count'
+type QueryHolderCountParams struct {
+	Denom string
 }
 
 // QueryTokenFeesParams is the query parameters for 'custom/token/fees'
 type QueryTokenFeesParams struct {
-	Symbol string
+	Symbol   string
+	FeeDenom string
 }
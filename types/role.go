@@ -0,0 +1,29 @@
+package types
+
+import (
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ParseRole parses a role from its name, e.g. admin, minter, metadata-editor or freezer
+func ParseRole(name string) (Role, error) {
+	name = strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(name), "-", "_"))
+	if !strings.HasPrefix(name, "ROLE_") {
+		name = "ROLE_" + name
+	}
+
+	role := Role(Role_value[name])
+	if err := ValidateRole(role); err != nil {
+		return role, err
+	}
+	return role, nil
+}
+
+// ValidateRole checks if the role is one of the defined roles
+func ValidateRole(role Role) error {
+	if _, ok := Role_name[int32(role)]; !ok || role == RoleUnspecified {
+		return sdkerrors.Wrapf(ErrInvalidRole, "invalid role %s", role)
+	}
+	return nil
+}
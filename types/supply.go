@@ -0,0 +1,27 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ToMainSupply converts the supply breakdown of the token to the main unit
+func (s TokenSupply) ToMainSupply(token TokenI) (TokenMainSupply, error) {
+	coins := []sdk.Coin{s.TotalSupply, s.MaxSupply, s.Mintable, s.Burned, s.ModuleHeld}
+
+	mainCoins := make([]sdk.DecCoin, len(coins))
+	for i, coin := range coins {
+		mainCoin, err := token.ToMainCoin(coin)
+		if err != nil {
+			return TokenMainSupply{}, err
+		}
+		mainCoins[i] = mainCoin
+	}
+
+	return TokenMainSupply{
+		TotalSupply: mainCoins[0],
+		MaxSupply:   mainCoins[1],
+		Mintable:    mainCoins[2],
+		Burned:      mainCoins[3],
+		ModuleHeld:  mainCoins[4],
+	}, nil
+}
@@ -18,10 +18,17 @@ type TokenI interface {
 	GetName() string
 	GetScale() uint32
 	GetMinUnit() string
-	GetInitialSupply() uint64
-	GetMaxSupply() uint64
+	GetInitialSupply() sdk.Int
+	GetMaxSupply() sdk.Int
 	GetMintable() bool
 	GetOwner() sdk.AccAddress
+	GetPaused() bool
+	GetDescription() string
+	GetWebsite() string
+	GetLogoURI() string
+	GetContentHash() string
+	GetLeaseExpireHeight() int64
+	GetLapsed() bool
 
 	ToMainCoin(coin sdk.Coin) (sdk.DecCoin, error)
 	ToMinCoin(coin sdk.DecCoin) (sdk.Coin, error)
@@ -36,7 +43,7 @@ func NewToken(
 	minUnit string,
 	scale uint32,
 	initialSupply,
-	maxSupply uint64,
+	maxSupply sdk.Int,
 	mintable bool,
 	owner sdk.AccAddress,
 ) Token {
@@ -44,9 +51,9 @@ func NewToken(
 	minUnit = strings.ToLower(strings.TrimSpace(minUnit))
 	name = strings.TrimSpace(name)
 
-	if maxSupply == 0 {
+	if maxSupply.IsNil() || maxSupply.IsZero() {
 		if mintable {
-			maxSupply = MaximumMaxSupply
+			maxSupply = sdk.NewIntFromUint64(MaximumMaxSupply)
 		} else {
 			maxSupply = initialSupply
 		}
@@ -85,12 +92,12 @@ func (t Token) GetMinUnit() string {
 }
 
 // GetInitialSupply implements exported.TokenI
-func (t Token) GetInitialSupply() uint64 {
+func (t Token) GetInitialSupply() sdk.Int {
 	return t.InitialSupply
 }
 
 // GetMaxSupply implements exported.TokenI
-func (t Token) GetMaxSupply() uint64 {
+func (t Token) GetMaxSupply() sdk.Int {
 	return t.MaxSupply
 }
 
@@ -104,6 +111,49 @@ func (t Token) GetOwner() sdk.AccAddress {
 	return t.Owner
 }
 
+// GetPaused implements exported.TokenI
+func (t Token) GetPaused() bool {
+	return t.Paused
+}
+
+// GetDescription implements exported.TokenI
+func (t Token) GetDescription() string {
+	return t.Description
+}
+
+// GetWebsite implements exported.TokenI
+func (t Token) GetWebsite() string {
+	return t.Website
+}
+
+// GetLogoURI implements exported.TokenI
+func (t Token) GetLogoURI() string {
+	return t.LogoURI
+}
+
+// GetContentHash implements exported.TokenI
+func (t Token) GetContentHash() string {
+	return t.ContentHash
+}
+
+// GetLeaseExpireHeight implements exported.TokenI
+func (t Token) GetLeaseExpireHeight() int64 {
+	return t.LeaseExpireHeight
+}
+
+// GetLapsed implements exported.TokenI
+func (t Token) GetLapsed() bool {
+	return t.Lapsed
+}
+
+// SetMetadata sets the optional metadata of the token
+func (t *Token) SetMetadata(description, website, logoURI, contentHash string) {
+	t.Description = strings.TrimSpace(description)
+	t.Website = strings.TrimSpace(website)
+	t.LogoURI = strings.TrimSpace(logoURI)
+	t.ContentHash = strings.TrimSpace(contentHash)
+}
+
 func (t Token) String() string {
 	bz, _ := yaml.Marshal(t)
 	return string(bz)
@@ -172,18 +222,43 @@ func ValidateToken(token Token) error {
 		return sdkerrors.Wrapf(ErrInvalidMinUnit, "invalid token min_unit %s, only accepts alphanumeric characters, and begin with an english letter, length [%d, %d]", token.MinUnit, MinimumMinUnitLen, MaximumMinUnitLen)
 	}
 
-	if token.InitialSupply > MaximumInitSupply {
-		return sdkerrors.Wrapf(ErrInvalidInitSupply, "invalid token initial supply %d, only accepts value [0, %d]", token.InitialSupply, MaximumInitSupply)
+	if token.InitialSupply.IsNil() || token.InitialSupply.IsNegative() || token.InitialSupply.GT(sdk.NewIntFromUint64(MaximumInitSupply)) {
+		return sdkerrors.Wrapf(ErrInvalidInitSupply, "invalid token initial supply %s, only accepts value [0, %d]", token.InitialSupply, MaximumInitSupply)
 	}
 
-	if token.MaxSupply < token.InitialSupply || token.MaxSupply > MaximumMaxSupply {
-		return sdkerrors.Wrapf(ErrInvalidMaxSupply, "invalid token max supply %d, only accepts value [%d, %d]", token.MaxSupply, token.InitialSupply, MaximumMaxSupply)
+	if token.MaxSupply.IsNil() || token.MaxSupply.LT(token.InitialSupply) || token.MaxSupply.GT(sdk.NewIntFromUint64(MaximumMaxSupply)) {
+		return sdkerrors.Wrapf(ErrInvalidMaxSupply, "invalid token max supply %s, only accepts value [%s, %d]", token.MaxSupply, token.InitialSupply, MaximumMaxSupply)
 	}
 
 	if token.Scale > MaximumScale {
 		return sdkerrors.Wrapf(ErrInvalidScale, "invalid token scale %d, only accepts value [0, %d]", token.Scale, MaximumScale)
 	}
 
+	if token.LeaseExpireHeight < 0 || token.Lapsed && token.LeaseExpireHeight == 0 {
+		return sdkerrors.Wrapf(ErrInvalidLease, "invalid symbol lease of the token %s expiring at height %d", token.Symbol, token.LeaseExpireHeight)
+	}
+
+	return ValidateMetadata(token.Description, token.Website, token.LogoURI, token.ContentHash)
+}
+
+// ValidateMetadata checks the lengths of the optional metadata of the token
+func ValidateMetadata(description, website, logoURI, contentHash string) error {
+	if len(description) > MaximumDescriptionLen {
+		return sdkerrors.Wrapf(ErrInvalidDescription, "invalid token description, only accepts length [0, %d]", MaximumDescriptionLen)
+	}
+
+	if len(website) > MaximumWebsiteLen {
+		return sdkerrors.Wrapf(ErrInvalidWebsite, "invalid token website %s, only accepts length [0, %d]", website, MaximumWebsiteLen)
+	}
+
+	if len(logoURI) > MaximumLogoURILen {
+		return sdkerrors.Wrapf(ErrInvalidLogoURI, "invalid token logo uri %s, only accepts length [0, %d]", logoURI, MaximumLogoURILen)
+	}
+
+	if len(contentHash) > MaximumContentHashLen {
+		return sdkerrors.Wrapf(ErrInvalidContentHash, "invalid token content hash %s, only accepts length [0, %d]", contentHash, MaximumContentHashLen)
+	}
+
 	return nil
 }
 
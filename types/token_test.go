@@ -14,8 +14,8 @@ func TestToken_ToMinCoin(t *testing.T) {
 		Name:          "irisnet",
 		Scale:         18,
 		MinUnit:       "atto",
-		InitialSupply: 1000000,
-		MaxSupply:     10000000,
+		InitialSupply: sdk.NewInt(1000000),
+		MaxSupply:     sdk.NewInt(10000000),
 		Mintable:      true,
 		Owner:         nil,
 	}
@@ -0,0 +1,96 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EmitTypedEvents emits the typed events, each as an event whose type is the full name of the proto message
+// and whose attributes are its JSON encoded fields
+func EmitTypedEvents(ctx sdk.Context, tevs ...proto.Message) error {
+	events := make(sdk.Events, len(tevs))
+	for i, tev := range tevs {
+		event, err := TypedEventToEvent(tev)
+		if err != nil {
+			return err
+		}
+		events[i] = event
+	}
+
+	ctx.EventManager().EmitEvents(events)
+	return nil
+}
+
+// TypedEventToEvent converts the typed event to an event
+func TypedEventToEvent(tev proto.Message) (sdk.Event, error) {
+	evtType := proto.MessageName(tev)
+	evtJSON, err := codec.ProtoMarshalJSON(tev)
+	if err != nil {
+		return sdk.Event{}, err
+	}
+
+	var attrMap map[string]json.RawMessage
+	if err := json.Unmarshal(evtJSON, &attrMap); err != nil {
+		return sdk.Event{}, err
+	}
+
+	// the attributes are sorted for a deterministic event
+	keys := make([]string, 0, len(attrMap))
+	for k := range attrMap {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	attrs := make([]abci.EventAttribute, 0, len(keys))
+	for _, k := range keys {
+		attrs = append(attrs, abci.EventAttribute{Key: []byte(k), Value: attrMap[k]})
+	}
+
+	return sdk.Event{Type: evtType, Attributes: attrs}, nil
+}
+
+// ParseTypedEvent converts the event emitted by EmitTypedEvents back to the typed event
+func ParseTypedEvent(event abci.Event) (proto.Message, error) {
+	concreteGoType := proto.MessageType(event.Type)
+	if concreteGoType == nil {
+		return nil, fmt.Errorf("failed to retrieve the message of type %q", event.Type)
+	}
+
+	var value reflect.Value
+	if concreteGoType.Kind() == reflect.Ptr {
+		value = reflect.New(concreteGoType.Elem())
+	} else {
+		value = reflect.Zero(concreteGoType)
+	}
+
+	protoMsg, ok := value.Interface().(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("%q does not implement proto.Message", event.Type)
+	}
+
+	attrMap := make(map[string]json.RawMessage)
+	for _, attr := range event.Attributes {
+		attrMap[string(attr.Key)] = attr.Value
+	}
+
+	attrBytes, err := json.Marshal(attrMap)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := jsonpb.Unmarshal(strings.NewReader(string(attrBytes)), protoMsg); err != nil {
+		return nil, err
+	}
+
+	return protoMsg, nil
+}
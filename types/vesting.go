@@ -0,0 +1,84 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ValidateAllocations checks the vesting allocations of the initial supply
+func ValidateAllocations(allocations []VestingAllocation, initialSupply sdk.Int) error {
+	total := sdk.ZeroInt()
+	recipients := make(map[string]bool, len(allocations))
+	for _, allocation := range allocations {
+		if allocation.Recipient.Empty() {
+			return sdkerrors.Wrapf(ErrInvalidAddress, "the recipient of the vesting allocation must be specified")
+		}
+
+		if recipients[allocation.Recipient.String()] {
+			return sdkerrors.Wrapf(ErrInvalidAllocation, "duplicate vesting allocation for the recipient %s", allocation.Recipient)
+		}
+		recipients[allocation.Recipient.String()] = true
+
+		if allocation.Amount.IsNil() || !allocation.Amount.IsPositive() {
+			return sdkerrors.Wrapf(ErrInvalidAllocation, "the amount of the vesting allocation for the recipient %s must be positive", allocation.Recipient)
+		}
+
+		if allocation.CliffTime != nil && allocation.CliffTime.After(allocation.EndTime) {
+			return sdkerrors.Wrapf(ErrInvalidAllocation, "the cliff time %s must not be later than the end time %s", allocation.CliffTime, allocation.EndTime)
+		}
+
+		total = total.Add(allocation.Amount)
+		if total.GT(initialSupply) {
+			return sdkerrors.Wrapf(ErrInvalidAllocation, "the total amount of the vesting allocations must not exceed the initial supply %s", initialSupply)
+		}
+	}
+	return nil
+}
+
+// VestedAmount returns the amount of the schedule vested at the specified time
+func (s VestingSchedule) VestedAmount(blockTime time.Time) sdk.Int {
+	switch {
+	case s.CliffTime != nil && blockTime.Before(*s.CliffTime):
+		return sdk.ZeroInt()
+	case !blockTime.Before(s.EndTime):
+		return s.Total.Amount
+	case !blockTime.After(s.StartTime):
+		return sdk.ZeroInt()
+	}
+
+	// the amount vests linearly between the start time and the end time
+	elapsed := sdk.NewInt(blockTime.Sub(s.StartTime).Nanoseconds())
+	duration := sdk.NewInt(s.EndTime.Sub(s.StartTime).Nanoseconds())
+	return s.Total.Amount.Mul(elapsed).Quo(duration)
+}
+
+// Validate checks the vesting schedule
+func (s VestingSchedule) Validate() error {
+	if err := CheckSymbol(s.Symbol); err != nil {
+		return err
+	}
+
+	if s.Recipient.Empty() {
+		return sdkerrors.Wrapf(ErrInvalidAddress, "the recipient of the vesting schedule of the token %s must be specified", s.Symbol)
+	}
+
+	if err := s.Total.Validate(); err != nil {
+		return err
+	}
+
+	if err := s.Released.Validate(); err != nil {
+		return err
+	}
+
+	if s.Released.Denom != s.Total.Denom || s.Released.Amount.GT(s.Total.Amount) {
+		return sdkerrors.Wrapf(ErrInvalidAllocation, "the released amount %s of the vesting schedule must not exceed the total amount %s", s.Released, s.Total)
+	}
+
+	if !s.EndTime.After(s.StartTime) {
+		return sdkerrors.Wrapf(ErrInvalidAllocation, "the end time %s must be later than the start time %s", s.EndTime, s.StartTime)
+	}
+
+	return nil
+}